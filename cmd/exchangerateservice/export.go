@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/garantex"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/exchangerate"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/export"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/pricing"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+)
+
+const exportUsage = `usage: exchangerateservice export -markets <markets> (-month <month> | -from <time> -to <time>) -out <file> [flags]
+
+Writes the stored rate history of the markets to a CSV or Parquet file. Times are
+Unix seconds, RFC 3339 or dates, e.g. 2026-09-01; without an offset they are taken
+in the -tz time zone.
+
+flags:`
+
+// exportTimeLayouts - accepted layouts of -from and -to besides Unix seconds
+var exportTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+// runExport runs the export command with its arguments and returns the exit code.
+func runExport(args []string) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), exportUsage)
+		flags.PrintDefaults()
+	}
+
+	var (
+		markets = flags.String("markets", "", "comma-separated markets, exported in the given order")
+		month   = flags.String("month", "", "calendar month to export, e.g. 2026-09; replaces -from and -to")
+		from    = flags.String("from", "", "start of the range, inclusive")
+		to      = flags.String("to", "", "end of the range, exclusive")
+		format  = flags.String("format", "", "csv or parquet, picked by the extension of -out by default")
+		columns = flags.String("columns", "", "comma-separated columns in file order, all by default: "+strings.Join(models.ExportColumns, ","))
+		tz      = flags.String("tz", "UTC", "IANA time zone of the range and of the exported timestamps")
+		out     = flags.String("out", "", "file to write")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	req, err := exportRequest(*markets, *month, *from, *to, *format, *columns, *tz, *out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flags.Usage()
+
+		return 2
+	}
+
+	ctx := context.Background()
+
+	cfg := config.Load()
+
+	log := utils.SetupLogger(cfg.Env)
+
+	// The export only reads the history: it must not start without it, nor pick up
+	// the rate queue of a running service.
+	cfg.Storage.DegradedMode = false
+	cfg.RateQueue.Enabled = false
+	cfg.RateWriter.Enabled = false
+
+	storage, err := connectStorage(ctx, log, cfg)
+	if err != nil {
+		log.Error("Failed to connect to storage", "error", err)
+
+		return 1
+	}
+	defer storage.Close(ctx)
+
+	exchangeRateModule, err := exchangerate.New(log, cfg, storage, storage, storage, garantex.NewClient(ctx, cfg), pricing.New(log, cfg, storage))
+	if err != nil {
+		log.Error("Failed to init exchange rate module", "error", err)
+
+		return 1
+	}
+
+	exportModule, err := export.New(log, cfg, exchangeRateModule)
+	if err != nil {
+		log.Error("Failed to init export module", "error", err)
+
+		return 1
+	}
+
+	if err = exportToFile(ctx, exportModule, req, *out); err != nil {
+		log.Error("Export failed", "error", err)
+
+		return 1
+	}
+
+	return 0
+}
+
+// exportRequest builds the export request from the flags.
+func exportRequest(markets, month, from, to, format, columns, tz, out string) (*models.ExportRequest, error) {
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("-tz: %w", err)
+	}
+
+	req := &models.ExportRequest{
+		Markets:  splitList(markets),
+		Format:   models.ExportFormat(format),
+		Columns:  splitList(columns),
+		Location: loc,
+	}
+
+	if out == "" {
+		return nil, errors.New("-out is required")
+	}
+
+	if format == "" {
+		req.Format = models.ExportFormatCSV
+		if strings.EqualFold(filepath.Ext(out), ".parquet") {
+			req.Format = models.ExportFormatParquet
+		}
+	}
+
+	switch {
+	case month != "" && (from != "" || to != ""):
+		return nil, errors.New("-month can not be combined with -from and -to")
+	case month != "":
+		start, err := time.ParseInLocation("2006-01", month, loc)
+		if err != nil {
+			return nil, fmt.Errorf("-month: %w", err)
+		}
+
+		req.From = start.Unix()
+		req.To = start.AddDate(0, 1, 0).Unix()
+	default:
+		if req.From, err = parseExportTime(from, loc); err != nil {
+			return nil, fmt.Errorf("-from: %w", err)
+		}
+
+		if req.To, err = parseExportTime(to, loc); err != nil {
+			return nil, fmt.Errorf("-to: %w", err)
+		}
+	}
+
+	return req, nil
+}
+
+// exportToFile writes the export to a temporary file next to path and renames
+// it, so that a failed export does not leave a truncated file behind.
+func exportToFile(ctx context.Context, m *export.Module, req *models.ExportRequest, path string) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+
+	if _, err = m.ExportRates(ctx, req, w); err != nil {
+		return err
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func parseExportTime(value string, loc *time.Location) (int64, error) {
+	if value == "" {
+		return 0, errors.New("is required")
+	}
+
+	if ts, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return ts, nil
+	}
+
+	for _, layout := range exportTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t.Unix(), nil
+		}
+	}
+
+	return 0, fmt.Errorf("can not parse %q", value)
+}
+
+// splitList splits a comma-separated flag value, dropping the blanks.
+func splitList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
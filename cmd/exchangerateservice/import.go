@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/importer"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+)
+
+const importUsage = `usage: exchangerateservice import [flags] <file.csv>
+
+Loads historical rates from a CSV file with a header line into PostgreSQL. The
+columns market, ts, ask_price and bid_price are required; source, fetched_at,
+latency_ms and request_id are optional. Rows of a market with a ts that is already
+stored or repeated in the file are skipped. An interrupted import resumes after
+the last loaded batch when run again.
+
+flags:`
+
+// runImport runs the import command with its arguments and returns the exit code.
+func runImport(args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), importUsage)
+		flags.PrintDefaults()
+	}
+
+	var (
+		dryRun  = flags.Bool("dry-run", false, "validate the file and check it against the stored history without storing anything")
+		restart = flags.Bool("restart", false, "start over, ignoring the progress of an interrupted import")
+		rejects = flags.String("rejects", "", "CSV report of the rows that were not imported, <file>.rejects.csv by default")
+		tz      = flags.String("tz", "UTC", "IANA time zone of the timestamps without an offset")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+
+		return 2
+	}
+
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "-tz:", err)
+
+		return 2
+	}
+
+	opts := importer.Options{
+		Path:        flags.Arg(0),
+		RejectsPath: *rejects,
+		DryRun:      *dryRun,
+		Restart:     *restart,
+		Location:    loc,
+	}
+
+	if opts.RejectsPath == "" {
+		opts.RejectsPath = opts.Path + ".rejects.csv"
+	}
+
+	ctx := context.Background()
+
+	cfg := config.Load()
+
+	log := utils.SetupLogger(cfg.Env)
+
+	if cfg.Storage.Driver != driverPostgres {
+		log.Error("Import needs PostgreSQL", "storage", cfg.Storage.Driver)
+
+		return 1
+	}
+
+	// The import must not pick up the rate queue of a running service.
+	cfg.RateQueue.Enabled = false
+	cfg.RateWriter.Enabled = false
+
+	storage, err := postgres.NewClient(ctx, log, cfg)
+	if err != nil {
+		log.Error("Failed to connect to database", "error", err)
+
+		return 1
+	}
+	defer storage.Close(ctx)
+
+	importModule, err := importer.New(log, cfg, storage)
+	if err != nil {
+		log.Error("Failed to init import module", "error", err)
+
+		return 1
+	}
+
+	report, err := importModule.Import(ctx, opts)
+	if report != nil {
+		printImportReport(report, opts)
+	}
+
+	if err != nil {
+		log.Error("Import failed, run it again to resume", "error", err)
+
+		return 1
+	}
+
+	return 0
+}
+
+// printImportReport prints the counters of the import to stdout.
+func printImportReport(report *importer.Report, opts importer.Options) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	if opts.DryRun {
+		fmt.Fprintln(w, "DRY RUN, nothing is stored")
+	}
+
+	fmt.Fprintf(w, "skipped as loaded before\t%d\trows\n", report.Resumed)
+	fmt.Fprintf(w, "read\t%d\trows\n", report.Rows)
+	fmt.Fprintf(w, "imported\t%d\trows\n", report.Imported)
+	fmt.Fprintf(w, "already stored\t%d\trows\n", report.Stored)
+	fmt.Fprintf(w, "duplicates\t%d\trows\n", report.Duplicates)
+	fmt.Fprintf(w, "rejected\t%d\trows, see %s\n", report.Rejected, opts.RejectsPath)
+
+	_ = w.Flush()
+}
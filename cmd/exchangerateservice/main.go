@@ -11,13 +11,32 @@ import (
 
 	"github.com/KVSH-user/ExchangeRateService/internal/adapters/garantex"
 	"github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres"
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/publisher"
 	"github.com/KVSH-user/ExchangeRateService/internal/app/grpc/exchangerateservice"
+	"github.com/KVSH-user/ExchangeRateService/internal/app/metrics"
 	"github.com/KVSH-user/ExchangeRateService/internal/config"
 	"github.com/KVSH-user/ExchangeRateService/internal/modules/exchangerate"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/export"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/outbox"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/pricing"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/quote"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/retention"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/rollup"
 	"github.com/KVSH-user/ExchangeRateService/internal/utils"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			os.Exit(runMigrate(os.Args[2:]))
+		case "export":
+			os.Exit(runExport(os.Args[2:]))
+		case "import":
+			os.Exit(runImport(os.Args[2:]))
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -25,7 +44,7 @@ func main() {
 
 	log := utils.SetupLogger(cfg.Env)
 
-	storage, err := postgres.NewClient(ctx, log, cfg)
+	storage, err := connectStorage(ctx, log, cfg)
 	if err != nil {
 		log.Error("Failed to connect to storage", "error", err)
 		os.Exit(1)
@@ -33,11 +52,109 @@ func main() {
 
 	garantexClient := garantex.NewClient(ctx, cfg)
 
-	exchangeRateModule := exchangerate.New(log, storage, garantexClient)
+	pricingModule := pricing.New(log, cfg, storage)
+
+	exchangeRateModule, err := exchangerate.New(log, cfg, storage, storage, storage, garantexClient, pricingModule)
+	if err != nil {
+		log.Error("Failed to init exchange rate module", "error", err)
+		os.Exit(1)
+	}
+
+	if err = exchangeRateModule.SeedMarkets(ctx); err != nil {
+		log.Error("Failed to seed markets", "error", err)
+		os.Exit(1)
+	}
+
+	// Partitions, rollups, the outbox and the rate fan-out are kept by PostgreSQL only.
+	pgStorage, _ := storage.(*postgres.Store)
+
+	retentionCtx, stopRetention := context.WithCancel(ctx)
+	retentionDone := make(chan struct{})
+
+	if pgStorage != nil {
+		retentionModule, err := retention.New(log, cfg, pgStorage)
+		if err != nil {
+			log.Error("Failed to init retention module", "error", err)
+			os.Exit(1)
+		}
+
+		go func() {
+			defer close(retentionDone)
+			retentionModule.Run(retentionCtx)
+		}()
+	} else {
+		close(retentionDone)
+	}
+
+	rollupCtx, stopRollup := context.WithCancel(ctx)
+	rollupDone := make(chan struct{})
+
+	if cfg.Rollup.Enabled {
+		rollupModule, err := rollup.New(log, cfg, pgStorage)
+		if err != nil {
+			log.Error("Failed to init rollup module", "error", err)
+			os.Exit(1)
+		}
+
+		go func() {
+			defer close(rollupDone)
+			rollupModule.Run(rollupCtx)
+		}()
+	} else {
+		close(rollupDone)
+	}
+
+	outboxCtx, stopOutbox := context.WithCancel(ctx)
+	outboxDone := make(chan struct{})
+
+	if cfg.Outbox.Enabled {
+		eventPublisher, err := publisher.New(log, cfg)
+		if err != nil {
+			log.Error("Failed to init outbox publisher", "error", err)
+			os.Exit(1)
+		}
+
+		outboxModule, err := outbox.New(log, cfg, pgStorage, eventPublisher)
+		if err != nil {
+			log.Error("Failed to init outbox module", "error", err)
+			os.Exit(1)
+		}
+
+		go func() {
+			defer close(outboxDone)
+			outboxModule.Run(outboxCtx)
+		}()
+	} else {
+		close(outboxDone)
+	}
 
-	server := exchangerateservice.NewServer(log, cfg.GRPC.Port, exchangeRateModule)
+	fanoutCtx, stopFanout := context.WithCancel(ctx)
+	fanoutDone := make(chan struct{})
 
-	errChan := make(chan error, 1)
+	if cfg.RateFanout.Enabled {
+		go func() {
+			defer close(fanoutDone)
+			pgStorage.ListenRates(fanoutCtx, exchangeRateModule.ReceiveRate)
+		}()
+	} else {
+		close(fanoutDone)
+	}
+
+	quoteModule := quote.New(log, cfg, storage, exchangeRateModule)
+
+	exportModule, err := export.New(log, cfg, exchangeRateModule)
+	if err != nil {
+		log.Error("Failed to init export module", "error", err)
+		os.Exit(1)
+	}
+
+	server, err := exchangerateservice.NewServer(log, cfg, exchangeRateModule, quoteModule, exportModule)
+	if err != nil {
+		log.Error("Failed to init gRPC server", "error", err)
+		os.Exit(1)
+	}
+
+	errChan := make(chan error, 2)
 
 	go func() {
 		if err := server.Start(ctx); err != nil {
@@ -45,6 +162,17 @@ func main() {
 		}
 	}()
 
+	var metricsServer *metrics.Server
+	if cfg.Metrics.Port != "" {
+		metricsServer = metrics.NewServer(log, cfg)
+
+		go func() {
+			if err := metricsServer.Start(ctx); err != nil {
+				errChan <- err
+			}
+		}()
+	}
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
@@ -65,5 +193,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	stopRetention()
+	<-retentionDone
+
+	stopRollup()
+	<-rollupDone
+
+	stopOutbox()
+	<-outboxDone
+
+	stopFanout()
+	<-fanoutDone
+
+	storage.Close(shutdownCtx)
+
+	if metricsServer != nil {
+		if err := metricsServer.Stop(shutdownCtx); err != nil {
+			log.Error("Error during metrics server shutdown", "error", err)
+		}
+	}
+
 	log.Info("Server stopped gracefully")
 }
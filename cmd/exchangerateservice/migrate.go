@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres"
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+	"github.com/KVSH-user/ExchangeRateService/migrations"
+)
+
+const migrateUsage = `usage: exchangerateservice migrate <command>
+
+commands:
+  up             apply all pending migrations
+  down           roll back the latest applied migration
+  redo           roll back the latest applied migration and apply it again
+  status         print the state of every migration
+  create <name>  write a new SQL migration to migrations/postgresql, run from the repository root`
+
+// runMigrate runs the migrate command with its arguments and returns the exit code.
+func runMigrate(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+
+		return 2
+	}
+
+	command := args[0]
+
+	switch command {
+	case "create":
+		if len(args) != 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+
+			return 2
+		}
+
+		// The migrations are embedded at build time, a new one is written to the sources.
+		if err := goose.Create(nil, filepath.Join("migrations", migrations.PostgresDir), args[1], "sql"); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+
+			return 1
+		}
+
+		return 0
+	case "up", "down", "redo", "status":
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+
+		return 2
+	}
+
+	ctx := context.Background()
+
+	cfg := config.Load()
+
+	log := utils.SetupLogger(cfg.Env)
+
+	client, err := postgres.NewMasterClient(ctx, log, cfg)
+	if err != nil {
+		log.Error("Failed to connect to database", "error", err)
+
+		return 1
+	}
+	defer client.Close(ctx)
+
+	m, err := client.Migrations(cfg)
+	if err != nil {
+		log.Error("Failed to load migrations", "error", err)
+
+		return 1
+	}
+	defer m.Close()
+
+	switch command {
+	case "up":
+		err = m.Up(ctx)
+	case "down":
+		err = m.Down(ctx)
+	case "redo":
+		err = m.Redo(ctx)
+	case "status":
+		err = printMigrationStatus(ctx, m)
+	}
+
+	if err != nil {
+		log.Error("Migrate failed", "command", command, "error", err)
+
+		return 1
+	}
+
+	return 0
+}
+
+// printMigrationStatus prints a line per migration to stdout.
+func printMigrationStatus(ctx context.Context, m *pgclient.Migrations) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tSTATE\tAPPLIED AT\tFILE")
+
+	for _, status := range statuses {
+		appliedAt := "-"
+		if !status.AppliedAt.IsZero() {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Source.Version, status.State, appliedAt, filepath.Base(status.Source.Path))
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/memory"
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres"
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/sqlite"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/exchangerate"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/pricing"
+	"github.com/KVSH-user/ExchangeRateService/internal/modules/quote"
+)
+
+const (
+	driverPostgres = "postgres"
+	driverSQLite   = "sqlite"
+	driverMemory   = "memory"
+)
+
+// storage - what every storage driver keeps for the modules
+type storage interface {
+	exchangerate.RateStorage
+	exchangerate.MarketStorage
+	exchangerate.OverrideStorage
+	pricing.RuleStorage
+	quote.Storage
+	Close(ctx context.Context)
+}
+
+var errUnknownDriver = errors.New("unknown storage driver")
+
+// connectStorage opens the storage, retrying with a doubling pause between the
+// attempts. Once the attempts are exhausted the service either fails to start or,
+// in the degraded mode, starts without the storage and serves live rates only.
+func connectStorage(ctx context.Context, log *slog.Logger, cfg *config.Config) (storage, error) {
+	backoff := cfg.Storage.ConnectBackoff
+
+	for attempt := 1; ; attempt++ {
+		s, err := openStorage(ctx, log, cfg)
+		if err == nil {
+			return s, nil
+		}
+
+		if errors.Is(err, errUnknownDriver) {
+			return nil, err
+		}
+
+		if attempt >= cfg.Storage.ConnectAttempts {
+			if !cfg.Storage.DegradedMode {
+				return nil, err
+			}
+
+			log.Error("Storage is unavailable, starting in degraded mode without the rate history", "error", err)
+			withoutPostgres(log, cfg, "degraded")
+
+			return memory.NewUnavailable(err), nil
+		}
+
+		log.Warn("Failed to connect to storage, retrying",
+			"attempt", attempt,
+			"attempts", cfg.Storage.ConnectAttempts,
+			"backoff", backoff,
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, cfg.Storage.ConnectMaxBackoff)
+	}
+}
+
+// openStorage opens the storage selected by the storage driver. The rollup, the
+// outbox and the rate fan-out need PostgreSQL and are turned off for the other drivers.
+func openStorage(ctx context.Context, log *slog.Logger, cfg *config.Config) (storage, error) {
+	switch cfg.Storage.Driver {
+	case driverPostgres:
+		return postgres.NewClient(ctx, log, cfg)
+	case driverSQLite, driverMemory:
+		withoutPostgres(log, cfg, cfg.Storage.Driver)
+
+		if cfg.Storage.Driver == driverMemory {
+			return memory.New(), nil
+		}
+
+		return sqlite.New(ctx, log, cfg)
+	default:
+		return nil, fmt.Errorf("%w %q", errUnknownDriver, cfg.Storage.Driver)
+	}
+}
+
+// withoutPostgres turns off the rollup, the outbox and the rate fan-out, which need
+// PostgreSQL.
+func withoutPostgres(log *slog.Logger, cfg *config.Config, reason string) {
+	if cfg.Rollup.Enabled || cfg.Outbox.Enabled || cfg.RateFanout.Enabled {
+		log.Warn("Rollup, outbox and rate fan-out need PostgreSQL, turning them off", "storage", reason)
+
+		cfg.Rollup.Enabled = false
+		cfg.Outbox.Enabled = false
+		cfg.RateFanout.Enabled = false
+	}
+}
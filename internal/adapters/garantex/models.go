@@ -8,6 +8,9 @@ import (
 	"github.com/KVSH-user/ExchangeRateService/internal/models"
 )
 
+// SourceName - provider name recorded with the rates fetched by this client
+const SourceName = "garantex"
+
 type Response struct {
 	Timestamp int   `json:"timestamp"`
 	Asks      []Ask `json:"asks"`
@@ -54,5 +57,55 @@ func (r Response) ToExchangeRateModel() (*models.ExchangeRate, error) {
 		AskPrice: ask,
 		BidPrice: bid,
 		TS:       int64(r.Timestamp),
+		Source:   SourceName,
 	}, nil
 }
+
+func (r Response) ToOrderBookModel() (*models.OrderBook, error) {
+	book := &models.OrderBook{
+		TS:   int64(r.Timestamp),
+		Asks: make([]models.OrderBookLevel, 0, len(r.Asks)),
+		Bids: make([]models.OrderBookLevel, 0, len(r.Bids)),
+	}
+
+	for _, ask := range r.Asks {
+		level, err := toOrderBookLevel(ask.Price, ask.Volume, ask.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert ask level: %w", err)
+		}
+
+		book.Asks = append(book.Asks, level)
+	}
+
+	for _, bid := range r.Bids {
+		level, err := toOrderBookLevel(bid.Price, bid.Volume, bid.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert bid level: %w", err)
+		}
+
+		book.Bids = append(book.Bids, level)
+	}
+
+	return book, nil
+}
+
+func toOrderBookLevel(price, volume, amount string) (models.OrderBookLevel, error) {
+	var (
+		level models.OrderBookLevel
+		err   error
+	)
+
+	if level.Price, err = newDecimal.NewFromString(price); err != nil {
+		return level, fmt.Errorf("price: %w", err)
+	}
+
+	if level.Volume, err = newDecimal.NewFromString(volume); err != nil {
+		return level, fmt.Errorf("volume: %w", err)
+	}
+
+	if level.Amount, err = newDecimal.NewFromString(amount); err != nil {
+		return level, fmt.Errorf("amount: %w", err)
+	}
+
+	return level, nil
+}
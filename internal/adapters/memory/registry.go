@@ -0,0 +1,198 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// Registry keeps the markets, rate overrides, pricing rules and quotes in memory.
+// Overrides are not audited and pricing rules cannot be managed, there are none.
+type Registry struct {
+	mu        sync.RWMutex
+	markets   map[string]models.Market
+	overrides map[string]models.RateOverride
+	quotes    map[string]models.Quote
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		markets:   make(map[string]models.Market),
+		overrides: make(map[string]models.RateOverride),
+		quotes:    make(map[string]models.Quote),
+	}
+}
+
+// ListMarkets - method for list all registered markets
+func (r *Registry) ListMarkets(_ context.Context) ([]*models.Market, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	markets := make([]*models.Market, 0, len(r.markets))
+
+	for _, symbol := range slices.Sorted(maps.Keys(r.markets)) {
+		market := r.markets[symbol]
+		markets = append(markets, &market)
+	}
+
+	return markets, nil
+}
+
+// GetMarket - method for get a registered market by symbol
+func (r *Registry) GetMarket(_ context.Context, symbol string) (*models.Market, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	market, ok := r.markets[symbol]
+	if !ok {
+		return nil, models.ErrMarketNotFound
+	}
+
+	return &market, nil
+}
+
+// UpsertMarket - method for register a market or replace its settings
+func (r *Registry) UpsertMarket(_ context.Context, market *models.Market) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	market.CreatedAt, market.UpdatedAt = now, now
+	if stored, ok := r.markets[market.Symbol]; ok {
+		market.CreatedAt = stored.CreatedAt
+	}
+
+	r.markets[market.Symbol] = *market
+
+	return nil
+}
+
+// SetMarketEnabled - method for enable or disable a registered market
+func (r *Registry) SetMarketEnabled(_ context.Context, symbol string, enabled bool) (*models.Market, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	market, ok := r.markets[symbol]
+	if !ok {
+		return nil, models.ErrMarketNotFound
+	}
+
+	market.Enabled = enabled
+	market.UpdatedAt = time.Now()
+	r.markets[symbol] = market
+
+	return &market, nil
+}
+
+// SeedMarkets - method for register the markets that are not registered yet,
+// settings of the already registered ones are kept
+func (r *Registry) SeedMarkets(_ context.Context, markets []*models.Market) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	for _, market := range markets {
+		if _, ok := r.markets[market.Symbol]; ok {
+			continue
+		}
+
+		seeded := *market
+		seeded.CreatedAt, seeded.UpdatedAt = now, now
+		r.markets[market.Symbol] = seeded
+	}
+
+	return nil
+}
+
+// ListRateOverrides - method for list the rate overrides that are not expired
+func (r *Registry) ListRateOverrides(_ context.Context) ([]*models.RateOverride, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+
+	var overrides []*models.RateOverride
+
+	for _, market := range slices.Sorted(maps.Keys(r.overrides)) {
+		override := r.overrides[market]
+		if override.Active(now) {
+			overrides = append(overrides, &override)
+		}
+	}
+
+	return overrides, nil
+}
+
+// SetRateOverride - method for set or replace the rate override of the market
+func (r *Registry) SetRateOverride(_ context.Context, override *models.RateOverride) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	override.CreatedAt = time.Now()
+	r.overrides[override.Market] = *override
+
+	return nil
+}
+
+// ClearRateOverride - method for remove the rate override of the market
+func (r *Registry) ClearRateOverride(_ context.Context, market, _, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.overrides[market]; !ok {
+		return models.ErrOverrideNotFound
+	}
+
+	delete(r.overrides, market)
+
+	return nil
+}
+
+// ListPricingRules - method for list the pricing rules, there are none
+func (r *Registry) ListPricingRules(_ context.Context) ([]*models.PricingRule, error) {
+	return nil, nil
+}
+
+// SaveQuote - method for save a new quote
+func (r *Registry) SaveQuote(_ context.Context, quote *models.Quote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.quotes[quote.ID]; ok {
+		return fmt.Errorf("SaveQuote: quote %s already exists", quote.ID)
+	}
+
+	quote.CreatedAt = time.Now()
+	r.quotes[quote.ID] = *quote
+
+	return nil
+}
+
+// RedeemQuote - method for mark the quote of the client as redeemed, at most once
+func (r *Registry) RedeemQuote(_ context.Context, id, clientID string) (*models.Quote, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	quote, ok := r.quotes[id]
+
+	switch {
+	case !ok || quote.ClientID != clientID:
+		return nil, models.ErrQuoteNotFound
+	case !quote.RedeemedAt.IsZero():
+		return nil, models.ErrQuoteRedeemed
+	case !quote.ExpiresAt.After(time.Now()):
+		return nil, models.ErrQuoteExpired
+	}
+
+	quote.RedeemedAt = time.Now()
+	r.quotes[id] = quote
+
+	return &quote, nil
+}
@@ -0,0 +1,189 @@
+// Package memory contains a storage that keeps everything in memory,
+// for local development without a database.
+package memory
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// Store keeps the rate history in memory, the history is lost on exit. Every
+// resolution is served from the raw history.
+type Store struct {
+	*Registry
+
+	mu     sync.RWMutex
+	lastID int64
+	// rates are ordered by (ts, id) per market.
+	rates map[string][]models.ExchangeRate
+}
+
+func New() *Store {
+	return &Store{
+		Registry: NewRegistry(),
+		rates:    make(map[string][]models.ExchangeRate),
+	}
+}
+
+// Close does nothing, the store holds no resources.
+func (s *Store) Close(_ context.Context) {}
+
+// Ping - the store is always available
+func (s *Store) Ping(_ context.Context) error {
+	return nil
+}
+
+// SaveExchangeRate - method for save exchange rate, the validity ranges of its
+// neighbours are updated
+func (s *Store) SaveExchangeRate(_ context.Context, rate *models.ExchangeRate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastID++
+	rate.ID = s.lastID
+
+	rates := s.rates[rate.Market]
+
+	// The id is the greatest one, so the rate goes after those with the same ts.
+	i := sort.Search(len(rates), func(i int) bool { return rates[i].TS > rate.TS })
+
+	rates = append(rates, models.ExchangeRate{})
+	copy(rates[i+1:], rates[i:])
+	rates[i] = *rate
+
+	if i > 0 {
+		rates[i-1].ValidTo = rates[i].TS
+	}
+
+	rates[i].ValidTo = 0
+	if i+1 < len(rates) {
+		rates[i].ValidTo = rates[i+1].TS
+	}
+
+	s.rates[rate.Market] = rates
+
+	return nil
+}
+
+// GetLatestExchangeRate - method for get the exchange rate snapshot of the market
+// that is still in effect
+func (s *Store) GetLatestExchangeRate(_ context.Context, market string) (*models.ExchangeRate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rates := s.rates[market]
+	if len(rates) == 0 {
+		return nil, models.ErrRateNotFound
+	}
+
+	return withoutBook(rates[len(rates)-1]), nil
+}
+
+// GetExchangeRateAt - method for get the exchange rate snapshot of the market whose
+// validity range contains ts, taken not earlier than notBefore
+func (s *Store) GetExchangeRateAt(
+	_ context.Context,
+	market string,
+	_ models.Resolution,
+	ts, notBefore int64,
+) (*models.ExchangeRate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rate, ok := s.rateAt(market, ts, notBefore)
+	if !ok {
+		return nil, models.ErrRateNotFound
+	}
+
+	return withoutBook(rate), nil
+}
+
+// GetOrderBookAt - method for get the order book of the market snapshot whose validity
+// range contains ts, taken not earlier than notBefore
+func (s *Store) GetOrderBookAt(_ context.Context, market string, ts, notBefore int64) (*models.OrderBook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rate, ok := s.rateAt(market, ts, notBefore)
+	if !ok || rate.Book == nil {
+		return nil, models.ErrOrderBookNotFound
+	}
+
+	book := *rate.Book
+	book.Market = market
+	book.TS = rate.TS
+
+	return &book, nil
+}
+
+// ListExchangeRates - method for list stored exchange rates of the market page by page,
+// ordered by (ts, id) and continuing after filter.After when it is set
+func (s *Store) ListExchangeRates(_ context.Context, filter models.RateFilter) ([]*models.ExchangeRate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	to := filter.To
+	if to == 0 {
+		to = math.MaxInt64
+	}
+
+	after := func(rate *models.ExchangeRate) bool {
+		if filter.After == nil {
+			return true
+		}
+
+		if filter.Desc {
+			return rate.TS < filter.After.TS || rate.TS == filter.After.TS && rate.ID < filter.After.ID
+		}
+
+		return rate.TS > filter.After.TS || rate.TS == filter.After.TS && rate.ID > filter.After.ID
+	}
+
+	rates := s.rates[filter.Market]
+	page := make([]*models.ExchangeRate, 0, filter.Limit)
+
+	for i := range rates {
+		if len(page) == filter.Limit {
+			break
+		}
+
+		rate := &rates[i]
+		if filter.Desc {
+			rate = &rates[len(rates)-1-i]
+		}
+
+		if rate.TS >= filter.From && rate.TS < to && after(rate) {
+			page = append(page, withoutBook(*rate))
+		}
+	}
+
+	return page, nil
+}
+
+// rateAt - the snapshot of the market in effect at ts, see GetExchangeRateAt
+func (s *Store) rateAt(market string, ts, notBefore int64) (models.ExchangeRate, bool) {
+	rates := s.rates[market]
+
+	i := sort.Search(len(rates), func(i int) bool { return rates[i].TS > ts }) - 1
+	if i < 0 {
+		return models.ExchangeRate{}, false
+	}
+
+	rate := rates[i]
+	if rate.TS < notBefore || rate.ValidTo != 0 && rate.ValidTo <= ts {
+		return models.ExchangeRate{}, false
+	}
+
+	return rate, true
+}
+
+// withoutBook - copy of the rate as the history queries return it
+func withoutBook(rate models.ExchangeRate) *models.ExchangeRate {
+	rate.Book = nil
+
+	return &rate
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/memory"
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/storagetest"
+)
+
+func TestStoreConformance(t *testing.T) {
+	if err := storagetest.TestRateStorage(context.Background(), memory.New()); err != nil {
+		t.Fatal(err)
+	}
+}
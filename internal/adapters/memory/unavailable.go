@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// Unavailable stands in for a storage that could not be opened. The markets,
+// overrides and quotes are kept in memory, the rate history is neither stored
+// nor served: every rate method fails with models.ErrStorageUnavailable.
+type Unavailable struct {
+	*Registry
+
+	// cause is the error the storage failed to open with.
+	cause error
+}
+
+func NewUnavailable(cause error) *Unavailable {
+	return &Unavailable{
+		Registry: NewRegistry(),
+		cause:    cause,
+	}
+}
+
+// Close does nothing, the store holds no resources.
+func (u *Unavailable) Close(_ context.Context) {}
+
+// Ping - reports the storage is unavailable together with its cause
+func (u *Unavailable) Ping(_ context.Context) error {
+	return fmt.Errorf("%w: %w", models.ErrStorageUnavailable, u.cause)
+}
+
+// SaveExchangeRate - method for save exchange rate, it is dropped
+func (u *Unavailable) SaveExchangeRate(_ context.Context, _ *models.ExchangeRate) error {
+	return u.err()
+}
+
+// GetLatestExchangeRate - method for get the exchange rate snapshot of the market
+// that is still in effect, there is none
+func (u *Unavailable) GetLatestExchangeRate(_ context.Context, _ string) (*models.ExchangeRate, error) {
+	return nil, u.err()
+}
+
+// GetExchangeRateAt - method for get the exchange rate snapshot of the market whose
+// validity range contains ts, there is none
+func (u *Unavailable) GetExchangeRateAt(_ context.Context, _ string, _ models.Resolution, _, _ int64) (*models.ExchangeRate, error) {
+	return nil, u.err()
+}
+
+// GetOrderBookAt - method for get the order book of the market snapshot whose validity
+// range contains ts, there is none
+func (u *Unavailable) GetOrderBookAt(_ context.Context, _ string, _, _ int64) (*models.OrderBook, error) {
+	return nil, u.err()
+}
+
+// ListExchangeRates - method for list stored exchange rates of the market, there are none
+func (u *Unavailable) ListExchangeRates(_ context.Context, _ models.RateFilter) ([]*models.ExchangeRate, error) {
+	return nil, u.err()
+}
+
+// err - error of the rate methods, the cause is only reported by Ping
+func (u *Unavailable) err() error {
+	return models.ErrStorageUnavailable
+}
@@ -22,37 +22,138 @@ type ConnClient interface {
 	// ExecTx executes a function within a transaction, applying optional transaction parameters.
 	ExecTx(ctx context.Context, f func(ctx context.Context, tx pgclient.DB) error, txParams ...pgclient.TxParams) error
 	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+	// WithTryAdvisoryLock runs f while holding the session advisory lock of key, unless another session holds it.
+	WithTryAdvisoryLock(ctx context.Context, key string, f func(ctx context.Context) error) (bool, error)
 	Close(ctx context.Context)
 }
 
 // Store contains connections to the master and replica databases.
 type Store struct {
 	Master ConnClient
-	logger *slog.Logger
+	// replicas serve the read-only history queries, nil when none are configured.
+	replicas *replicaSet
+	logger   *slog.Logger
+	// writer batches rate inserts when the write-behind mode is enabled.
+	writer *rateWriter
+	// outbox enables the rate events written together with the rates.
+	outbox bool
+	// queue keeps the rates while master is unreachable, nil when disabled.
+	queue *rateQueue
+	// fanout notifies the other instances of the saved rates, nil when disabled.
+	fanout *rateFanout
 }
 
 // NewClient creates a new Store instance based on the provided configuration.
 func NewClient(ctx context.Context, logger *slog.Logger, cfg *config.Config) (*Store, error) {
-	masterConnCfg := pgclient.ClientConfig{
+	masterConnCfg := masterClientConfig(cfg)
+	masterConnCfg.SkipMigrations = !cfg.Postgres.AutoMigrate
+
+	masterConn, err := pgclient.NewClient(ctx, cfg, masterConnCfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &Store{
+		Master: masterConn,
+		logger: logger,
+		outbox: cfg.Outbox.Enabled,
+	}
+
+	if cfg.RateFanout.Enabled {
+		store.fanout = &rateFanout{
+			channel:       cfg.RateFanout.Channel,
+			listener:      masterConn.Listener(cfg.RateFanout.Channel, cfg.RateFanout.ReconnectBackoff, cfg.RateFanout.MaxReconnectBackoff),
+			catchUpWindow: cfg.RateFanout.CatchUpWindow,
+		}
+	}
+
+	if len(cfg.Postgres.Replicas) > 0 {
+		replicas := make([]*replica, 0, len(cfg.Postgres.Replicas))
+
+		for _, addr := range cfg.Postgres.Replicas {
+			replicaConn, err := pgclient.NewClient(ctx, cfg, &pgclient.ClientConfig{
+				Host:     addr,
+				Login:    cfg.Postgres.Login,
+				Password: cfg.Postgres.Password,
+				DBName:   cfg.Postgres.DbName,
+				Replica:  true,
+			}, logger)
+			if err != nil {
+				for _, r := range replicas {
+					r.conn.Close(ctx)
+				}
+
+				masterConn.Close(ctx)
+
+				return nil, fmt.Errorf("replica %s: %w", addr, err)
+			}
+
+			replicas = append(replicas, &replica{addr: addr, conn: replicaConn})
+		}
+
+		store.replicas = newReplicaSet(logger, masterConn, replicas, cfg.Postgres.ReplicaMaxLag, cfg.Postgres.ReplicaCheckInterval)
+		store.replicas.start(ctx)
+	}
+
+	if cfg.RateQueue.Enabled {
+		store.queue, err = newRateQueue(logger, cfg.RateQueue, store.replayExchangeRates)
+		if err != nil {
+			store.Close(ctx)
+
+			return nil, fmt.Errorf("rate queue: %w", err)
+		}
+	}
+
+	if cfg.RateWriter.Enabled {
+		store.writer = newRateWriter(logger, cfg.RateWriter, store.flushExchangeRates)
+	}
+
+	return store, nil
+}
+
+// NewMasterClient connects to master without applying the pending migrations, for
+// the migrate command.
+func NewMasterClient(ctx context.Context, logger *slog.Logger, cfg *config.Config) (*pgclient.Client, error) {
+	masterConnCfg := masterClientConfig(cfg)
+	masterConnCfg.SkipMigrations = true
+
+	return pgclient.NewClient(ctx, cfg, masterConnCfg, logger)
+}
+
+// masterClientConfig - connection settings of master
+func masterClientConfig(cfg *config.Config) *pgclient.ClientConfig {
+	return &pgclient.ClientConfig{
 		Host:     fmt.Sprintf("%s:%d", cfg.Postgres.Host, cfg.Postgres.MasterPort),
 		Login:    cfg.Postgres.Login,
 		Password: cfg.Postgres.Password,
 		DBName:   cfg.Postgres.DbName,
 	}
+}
 
-	masterConn, err := pgclient.NewClient(ctx, cfg, &masterConnCfg, logger)
-	if err != nil {
-		return nil, err
+// Ping - checks master accepts queries
+func (s *Store) Ping(ctx context.Context) error {
+	if _, err := s.exec(ctx, "SELECT 1", s.Master); err != nil {
+		return fmt.Errorf("Ping: %w", err)
 	}
 
-	return &Store{
-		Master: masterConn,
-		logger: logger,
-	}, nil
+	return nil
 }
 
-// Close closes the master and replica database connections.
+// Close flushes the buffered rates and closes the master and replica database connections.
 func (s *Store) Close(ctx context.Context) {
+	if s.writer != nil {
+		if err := s.writer.close(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to flush buffered rates", "error", err)
+		}
+	}
+
+	if s.queue != nil {
+		if err := s.queue.close(); err != nil {
+			s.logger.ErrorContext(ctx, "failed to close rate queue", "error", err)
+		}
+	}
+
+	s.replicas.close(ctx)
 	s.Master.Close(ctx)
 }
 
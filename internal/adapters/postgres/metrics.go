@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	rateWriterFlushDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_writer",
+		Name:      "flush_duration_seconds",
+		Help:      "Duration of flushing buffered rates to the database.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	rateWriterFlushedRows = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_writer",
+		Name:      "flushed_rows_total",
+		Help:      "Number of buffered rates written to the database.",
+	})
+
+	rateWriterDroppedRows = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_writer",
+		Name:      "dropped_rows_total",
+		Help:      "Number of rates that were not written to the database, by reason.",
+	}, []string{"reason"})
+
+	rateWriterQueueLength = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_writer",
+		Name:      "queue_length",
+		Help:      "Number of rates waiting to be flushed.",
+	})
+)
+
+var (
+	replicaLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "replica_lag_seconds",
+		Help:      "Replication lag of the read replicas.",
+	}, []string{"replica"})
+
+	replicaHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "replica_healthy",
+		Help:      "Whether the read replica serves the read-only queries.",
+	}, []string{"replica"})
+)
+
+var (
+	rateQueueLength = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_queue",
+		Name:      "length",
+		Help:      "Number of rates kept on disk until the database is reachable.",
+	})
+
+	rateQueueBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_queue",
+		Name:      "size_bytes",
+		Help:      "Size of the segment files of the rate queue.",
+	})
+
+	rateQueueQueuedRows = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_queue",
+		Name:      "queued_rows_total",
+		Help:      "Number of rates queued on disk while the database was unreachable.",
+	})
+
+	rateQueueReplayedRows = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_queue",
+		Name:      "replayed_rows_total",
+		Help:      "Number of queued rates written to the database.",
+	})
+
+	rateQueueDroppedRows = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "rate_queue",
+		Name:      "dropped_rows_total",
+		Help:      "Number of rates that did not fit into the queue or could not be read back.",
+	})
+)
+
+var outboxDeadEvents = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "exchangerateservice",
+	Subsystem: "outbox",
+	Name:      "dead_events_total",
+	Help:      "Number of outbox events dead-lettered after failing the maximum number of deliveries.",
+})
+
+var rateFanoutRefNotifications = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "exchangerateservice",
+	Subsystem: "rate_fanout",
+	Name:      "ref_notifications_total",
+	Help:      "Number of saved rates sent to the other instances as a reference, their notification being too large.",
+})
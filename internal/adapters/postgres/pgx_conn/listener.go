@@ -0,0 +1,114 @@
+package pgx_conn
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Listener receives the notifications of a channel on a dedicated connection, as
+// LISTEN does not survive the return of a connection to the pool.
+type Listener struct {
+	connConfig *pgx.ConnConfig
+	channel    string
+	backoff    time.Duration
+	maxBackoff time.Duration
+	logger     *slog.Logger
+}
+
+// Listener returns a listener of the channel connecting with the settings of the
+// pool. A lost connection is retried after backoff, doubled up to maxBackoff while
+// the attempts fail.
+func (c *Client) Listener(channel string, backoff, maxBackoff time.Duration) *Listener {
+	return &Listener{
+		connConfig: c.pgxPool.Config().ConnConfig,
+		channel:    channel,
+		backoff:    backoff,
+		maxBackoff: maxBackoff,
+		logger:     c.logger,
+	}
+}
+
+// Run listens until ctx is done. After every connection, once LISTEN is in effect,
+// onConnect is called to catch up on what was missed while disconnected, then handle
+// is called with the payload of every notification in the order they were sent. A
+// failed onConnect counts as a lost connection.
+func (l *Listener) Run(ctx context.Context, onConnect func(ctx context.Context) error, handle func(ctx context.Context, payload string)) {
+	backoff := l.backoff
+
+	for {
+		connected, err := l.listen(ctx, onConnect, handle)
+		if ctx.Err() != nil {
+			return
+		}
+
+		listenerReconnects.WithLabelValues(l.channel).Inc()
+
+		if connected {
+			backoff = l.backoff
+		}
+
+		l.logger.WarnContext(ctx, "Listener lost its connection, reconnecting",
+			"channel", l.channel,
+			"backoff", backoff,
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		if !connected {
+			backoff = min(backoff*2, l.maxBackoff)
+		}
+	}
+}
+
+// listen runs one connection of the listener until it fails or ctx is done.
+// connected reports whether it got as far as receiving notifications.
+func (l *Listener) listen(
+	ctx context.Context,
+	onConnect func(ctx context.Context) error,
+	handle func(ctx context.Context, payload string),
+) (connected bool, err error) {
+	conn, err := pgx.ConnectConfig(ctx, l.connConfig)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		listenerConnected.WithLabelValues(l.channel).Set(0)
+
+		// The connection may be broken, it is not worth waiting for.
+		closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
+		defer cancel()
+
+		_ = conn.Close(closeCtx)
+	}()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return false, err
+	}
+
+	if err = onConnect(ctx); err != nil {
+		return false, err
+	}
+
+	listenerConnected.WithLabelValues(l.channel).Set(1)
+	l.logger.InfoContext(ctx, "Listening for notifications", "channel", l.channel)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		notificationsReceived.WithLabelValues(l.channel).Inc()
+
+		handle(ctx, notification.Payload)
+	}
+}
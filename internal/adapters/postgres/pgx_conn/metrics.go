@@ -0,0 +1,158 @@
+package pgx_conn
+
+import (
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of the database statements, by pool, operation and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"pool", "operation", "status"})
+
+	slowQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "slow_queries_total",
+		Help:      "Number of database statements slower than the slow query threshold.",
+	}, []string{"pool", "operation"})
+
+	poolAcquireWaiting = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "pool_acquire_waiting",
+		Help:      "Number of callers waiting to acquire a connection from the pool.",
+	}, []string{"pool"})
+
+	poolAcquireDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "pool_acquire_duration_seconds",
+		Help:      "Duration of acquiring a connection from the pool.",
+		Buckets:   []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1, 5},
+	}, []string{"pool"})
+
+	listenerConnected = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "listener_connected",
+		Help:      "Whether the listener of the channel is connected and listening.",
+	}, []string{"channel"})
+
+	listenerReconnects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "listener_reconnects_total",
+		Help:      "Number of times the listener of the channel lost its connection.",
+	}, []string{"channel"})
+
+	notificationsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "exchangerateservice",
+		Subsystem: "postgres",
+		Name:      "notifications_received_total",
+		Help:      "Number of notifications received on the channel.",
+	}, []string{"channel"})
+)
+
+// poolStats exposes pgxpool.Stat of the open pools when the metrics are scraped.
+var poolStats = newPoolCollector()
+
+func init() {
+	prometheus.MustRegister(poolStats)
+}
+
+// poolCollector collects the statistics of the registered pools.
+type poolCollector struct {
+	mu    sync.Mutex
+	pools map[string]*pgxpool.Pool
+
+	acquiredConns     *prometheus.Desc
+	idleConns         *prometheus.Desc
+	constructingConns *prometheus.Desc
+	totalConns        *prometheus.Desc
+	maxConns          *prometheus.Desc
+	acquires          *prometheus.Desc
+	emptyAcquires     *prometheus.Desc
+	canceledAcquires  *prometheus.Desc
+	acquireDuration   *prometheus.Desc
+	emptyAcquireWait  *prometheus.Desc
+}
+
+func newPoolCollector() *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("exchangerateservice", "postgres", name), help, []string{"pool"}, nil)
+	}
+
+	return &poolCollector{
+		pools: make(map[string]*pgxpool.Pool),
+
+		acquiredConns:     desc("pool_acquired_conns", "Number of connections currently acquired from the pool."),
+		idleConns:         desc("pool_idle_conns", "Number of idle connections in the pool."),
+		constructingConns: desc("pool_constructing_conns", "Number of connections being established."),
+		totalConns:        desc("pool_total_conns", "Number of connections in the pool."),
+		maxConns:          desc("pool_max_conns", "Maximum size of the pool."),
+		acquires:          desc("pool_acquires_total", "Number of successful acquires from the pool."),
+		emptyAcquires:     desc("pool_empty_acquires_total", "Number of acquires that had to wait for a connection."),
+		canceledAcquires:  desc("pool_canceled_acquires_total", "Number of acquires canceled by their context."),
+		acquireDuration:   desc("pool_acquire_seconds_total", "Total time spent acquiring connections from the pool."),
+		emptyAcquireWait:  desc("pool_empty_acquire_wait_seconds_total", "Total time spent waiting for a connection when the pool had none idle."),
+	}
+}
+
+// add starts exposing the statistics of the pool.
+func (c *poolCollector) add(name string, pool *pgxpool.Pool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pools[name] = pool
+}
+
+// remove stops exposing the statistics of the pool.
+func (c *poolCollector) remove(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.pools, name)
+}
+
+// Describe implements prometheus.Collector.
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.constructingConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquires
+	ch <- c.emptyAcquires
+	ch <- c.canceledAcquires
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquireWait
+}
+
+// Collect implements prometheus.Collector.
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, pool := range c.pools {
+		stat := pool.Stat()
+
+		ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()), name)
+		ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()), name)
+		ch <- prometheus.MustNewConstMetric(c.constructingConns, prometheus.GaugeValue, float64(stat.ConstructingConns()), name)
+		ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()), name)
+		ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()), name)
+		ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()), name)
+		ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()), name)
+		ch <- prometheus.MustNewConstMetric(c.canceledAcquires, prometheus.CounterValue, float64(stat.CanceledAcquireCount()), name)
+		ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds(), name)
+		ch <- prometheus.MustNewConstMetric(c.emptyAcquireWait, prometheus.CounterValue, stat.EmptyAcquireWaitTime().Seconds(), name)
+	}
+}
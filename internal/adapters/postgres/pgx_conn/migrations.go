@@ -0,0 +1,139 @@
+package pgx_conn
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+
+	"github.com/KVSH-user/ExchangeRateService/migrations"
+)
+
+// migrationLockProbe - how often a held migration lock is probed again
+const migrationLockProbe = 5 * time.Second
+
+// Migrations applies the migrations embedded into the binary. Every command holds
+// a session advisory lock, so replicas of the service starting at once apply the
+// pending migrations one at a time and the later ones find nothing to do.
+type Migrations struct {
+	provider *goose.Provider
+	// unlocked runs the commands under a lock taken by the caller.
+	unlocked *goose.Provider
+	locker   lock.SessionLocker
+	db       *sql.DB
+}
+
+// newMigrations creates the migrations of the database behind the pool, the lock is
+// waited for up to lockTimeout.
+func newMigrations(pgxPool *pgxpool.Pool, lockTimeout time.Duration, logger *slog.Logger) (*Migrations, error) {
+	fsys, err := fs.Sub(migrations.FS, migrations.PostgresDir)
+	if err != nil {
+		return nil, err
+	}
+
+	locker, err := lock.NewPostgresSessionLocker(
+		lock.WithLockTimeout(uint64(migrationLockProbe.Seconds()), uint64(max(lockTimeout/migrationLockProbe, 1))),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("migration lock: %w", err)
+	}
+
+	db := stdlib.OpenDBFromPool(pgxPool)
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, fsys,
+		goose.WithSessionLocker(locker),
+		goose.WithSlog(logger.With("component", "migrations")),
+	)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("migrations: %w", err)
+	}
+
+	unlocked, err := goose.NewProvider(goose.DialectPostgres, db, fsys,
+		goose.WithSlog(logger.With("component", "migrations")),
+	)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("migrations: %w", err)
+	}
+
+	return &Migrations{
+		provider: provider,
+		unlocked: unlocked,
+		locker:   locker,
+		db:       db,
+	}, nil
+}
+
+// Up applies all pending migrations.
+func (m *Migrations) Up(ctx context.Context) error {
+	if _, err := m.provider.Up(ctx); err != nil {
+		return fmt.Errorf("migrate up: %w", err)
+	}
+
+	return nil
+}
+
+// Down rolls back the latest applied migration.
+func (m *Migrations) Down(ctx context.Context) error {
+	if _, err := m.provider.Down(ctx); err != nil {
+		return fmt.Errorf("migrate down: %w", err)
+	}
+
+	return nil
+}
+
+// Redo rolls back the latest applied migration and applies it again. Both steps
+// run under one lock, so a replica applying the pending migrations on startup
+// does not apply the rolled back one in between.
+func (m *Migrations) Redo(ctx context.Context) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate redo: %w", err)
+	}
+	defer conn.Close()
+
+	if err = m.locker.SessionLock(ctx, conn); err != nil {
+		return fmt.Errorf("migrate redo: %w", err)
+	}
+
+	defer func() {
+		if unlockErr := m.locker.SessionUnlock(context.WithoutCancel(ctx), conn); unlockErr != nil && err == nil {
+			err = fmt.Errorf("migrate redo: %w", unlockErr)
+		}
+	}()
+
+	if _, err = m.unlocked.Down(ctx); err != nil {
+		return fmt.Errorf("migrate down: %w", err)
+	}
+
+	if _, err = m.unlocked.UpByOne(ctx); err != nil {
+		return fmt.Errorf("migrate up: %w", err)
+	}
+
+	return nil
+}
+
+// Status returns the state of every embedded migration, ordered by version.
+func (m *Migrations) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate status: %w", err)
+	}
+
+	return statuses, nil
+}
+
+// Close releases the connection to the database, the pool stays open.
+func (m *Migrations) Close() error {
+	return m.db.Close()
+}
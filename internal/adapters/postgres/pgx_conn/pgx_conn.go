@@ -3,21 +3,16 @@ package pgx_conn
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/jackc/pgx/v5/stdlib"
-	"github.com/pressly/goose"
 
 	"github.com/KVSH-user/ExchangeRateService/internal/config"
 )
 
-const migrationsPath = "migrations/postgresql"
-
 // DB defines the interface for database operations.
 type DB interface {
 	Exec(ctx context.Context, sql string, args ...any) (commandTag pgconn.CommandTag, err error)
@@ -32,18 +27,29 @@ type ClientConfig struct {
 	Login    string
 	Password string
 	DBName   string
+	// Replica marks a read replica, migrations are only applied on master.
+	Replica bool
+	// SkipMigrations leaves the pending migrations of master to the migrate command.
+	SkipMigrations bool
 }
 
 // Client defines the PostgreSQL client.
 type Client struct {
 	pgxPool *pgxpool.Pool
 	logger  *slog.Logger
+	// name labels the metrics of the pool: "master" or the replica host.
+	name string
 }
 
 // NewClient creates a new PostgreSQL client instance based on the provided configuration.
 func NewClient(ctx context.Context, cfg *config.Config, pgCfg *ClientConfig, logger *slog.Logger) (*Client, error) {
+	name := "master"
+	if pgCfg.Replica {
+		name = pgCfg.Host
+	}
+
 	dataBaseURL := connectionString(pgCfg)
-	poolCfg, err := newConfig(ctx, cfg, dataBaseURL, logger)
+	poolCfg, err := newConfig(ctx, cfg, dataBaseURL, name, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -53,25 +59,50 @@ func NewClient(ctx context.Context, cfg *config.Config, pgCfg *ClientConfig, log
 		return nil, err
 	}
 
-	sqlDB := stdlib.OpenDBFromPool(pgxPool)
-	defer sqlDB.Close()
-
-	if err = goose.SetDialect("postgres"); err != nil {
-		return nil, fmt.Errorf("setting postgres dialect: %w", err)
+	client := &Client{
+		pgxPool: pgxPool,
+		logger:  logger,
+		name:    name,
 	}
 
-	if err = goose.Up(sqlDB, migrationsPath); err != nil {
-		return nil, fmt.Errorf("goose up: %w", err)
+	poolStats.add(name, pgxPool)
+
+	if !pgCfg.Replica && !pgCfg.SkipMigrations {
+		if err = client.migrate(ctx, cfg); err != nil {
+			client.Close(ctx)
+
+			return nil, err
+		}
 	}
 
 	if err = pgxPool.Ping(ctx); err != nil {
-		return nil, err
+		// A replica that is down is skipped by the readers until it is back.
+		if !pgCfg.Replica {
+			client.Close(ctx)
+
+			return nil, err
+		}
+
+		logger.WarnContext(ctx, "Replica is not reachable", "host", pgCfg.Host, "error", err)
 	}
 
-	return &Client{
-		pgxPool: pgxPool,
-		logger:  logger,
-	}, nil
+	return client, nil
+}
+
+// Migrations returns the migrations of the database, to be closed by the caller.
+func (c *Client) Migrations(cfg *config.Config) (*Migrations, error) {
+	return newMigrations(c.pgxPool, cfg.Postgres.MigrationLockTimeout, c.logger)
+}
+
+// migrate applies the pending migrations.
+func (c *Client) migrate(ctx context.Context, cfg *config.Config) error {
+	m, err := c.Migrations(cfg)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	return m.Up(ctx)
 }
 
 // connectionString creates a PostgreSQL connection string based on the provided
@@ -116,6 +147,7 @@ func connectionString(cfg *ClientConfig) string {
 
 // Close closes the database connection pool.
 func (c *Client) Close(_ context.Context) {
+	poolStats.remove(c.name)
 	c.pgxPool.Close()
 }
 
@@ -157,6 +189,34 @@ func (c *Client) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 	return c.pgxPool.QueryRow(ctx, sql, args...)
 }
 
+// WithTryAdvisoryLock runs f while holding the session advisory lock of key, unless
+// another session holds it; ok reports whether f ran. The lock is held on a connection
+// of its own, so f may run any number of transactions. It is released when f returns
+// or, should the process die, with the connection.
+func (c *Client) WithTryAdvisoryLock(ctx context.Context, key string, f func(ctx context.Context) error) (ok bool, err error) {
+	conn, err := c.pgxPool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+
+	if err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, key).Scan(&ok); err != nil || !ok {
+		return false, err
+	}
+
+	defer func() {
+		unlockCtx := context.WithoutCancel(ctx)
+
+		if _, unlockErr := conn.Exec(unlockCtx, `SELECT pg_advisory_unlock(hashtext($1))`, key); unlockErr != nil {
+			// The lock must not go back to the pool with the connection.
+			c.logger.ErrorContext(ctx, "Failed to release advisory lock, closing its connection", "key", key, "error", unlockErr)
+			_ = conn.Conn().Close(unlockCtx)
+		}
+	}()
+
+	return true, f(ctx)
+}
+
 // ExecTx executes a function within a transaction, applying optional transaction parameters.
 func (c *Client) ExecTx(ctx context.Context, f func(ctx context.Context, tx DB) error, txParams ...TxParams) error {
 	const fName = "ExecTx"
@@ -210,7 +270,8 @@ func (c *Client) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnN
 }
 
 // newConfig creates a new pgxpool.Config object based on the provided configuration.
-func newConfig(ctx context.Context, cfg *config.Config, dataBaseURL string, logger *slog.Logger) (*pgxpool.Config, error) {
+// The statements and acquires of the pool are traced under the name.
+func newConfig(ctx context.Context, cfg *config.Config, dataBaseURL, name string, logger *slog.Logger) (*pgxpool.Config, error) {
 	const fn = "newConfig"
 	log := logger.With("fn", fn)
 
@@ -221,13 +282,7 @@ func newConfig(ctx context.Context, cfg *config.Config, dataBaseURL string, logg
 
 	setWithConfig(dbConfig, &cfg.Postgres)
 
-	dbConfig.BeforeAcquire = func(_ context.Context, _ *pgx.Conn) bool {
-		return true
-	}
-
-	dbConfig.AfterRelease = func(_ *pgx.Conn) bool {
-		return true
-	}
+	dbConfig.ConnConfig.Tracer = newTracer(name, cfg.Postgres.SlowQueryThreshold, logger)
 
 	dbConfig.BeforeClose = func(_ *pgx.Conn) {
 		log.InfoContext(ctx, "Closed the connection pool to the database!")
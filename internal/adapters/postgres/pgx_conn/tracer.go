@@ -0,0 +1,154 @@
+package pgx_conn
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type (
+	queryTraceKey   struct{}
+	acquireTraceKey struct{}
+)
+
+// queryTrace - statement in flight, kept in its context
+type queryTrace struct {
+	start time.Time
+	sql   string
+	args  []any
+}
+
+// tracer records the duration of the statements of a pool, logs the ones slower than
+// slowQuery and counts the callers waiting for a connection.
+type tracer struct {
+	pool      string
+	slowQuery time.Duration
+	logger    *slog.Logger
+}
+
+var (
+	_ pgx.QueryTracer       = (*tracer)(nil)
+	_ pgx.CopyFromTracer    = (*tracer)(nil)
+	_ pgxpool.AcquireTracer = (*tracer)(nil)
+)
+
+func newTracer(pool string, slowQuery time.Duration, logger *slog.Logger) *tracer {
+	return &tracer{
+		pool:      pool,
+		slowQuery: slowQuery,
+		logger:    logger,
+	}
+}
+
+// TraceQueryStart is called at the beginning of Query, QueryRow and Exec.
+func (t *tracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryTraceKey{}, &queryTrace{
+		start: time.Now(),
+		sql:   data.SQL,
+		args:  data.Args,
+	})
+}
+
+// TraceQueryEnd is called when Query, QueryRow or Exec is done.
+func (t *tracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	t.end(ctx, "query", data.Err)
+}
+
+// TraceCopyFromStart is called at the beginning of CopyFrom.
+func (t *tracer) TraceCopyFromStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromStartData) context.Context {
+	return context.WithValue(ctx, queryTraceKey{}, &queryTrace{
+		start: time.Now(),
+		sql:   fmt.Sprintf("COPY %s (%s) FROM STDIN", data.TableName.Sanitize(), strings.Join(data.ColumnNames, ", ")),
+	})
+}
+
+// TraceCopyFromEnd is called when CopyFrom is done.
+func (t *tracer) TraceCopyFromEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromEndData) {
+	t.end(ctx, "copy", data.Err)
+}
+
+// TraceAcquireStart is called when a connection is requested from the pool.
+func (t *tracer) TraceAcquireStart(ctx context.Context, _ *pgxpool.Pool, _ pgxpool.TraceAcquireStartData) context.Context {
+	poolAcquireWaiting.WithLabelValues(t.pool).Inc()
+
+	return context.WithValue(ctx, acquireTraceKey{}, time.Now())
+}
+
+// TraceAcquireEnd is called when the connection is acquired or the request failed.
+func (t *tracer) TraceAcquireEnd(ctx context.Context, _ *pgxpool.Pool, _ pgxpool.TraceAcquireEndData) {
+	poolAcquireWaiting.WithLabelValues(t.pool).Dec()
+
+	if start, ok := ctx.Value(acquireTraceKey{}).(time.Time); ok {
+		poolAcquireDuration.WithLabelValues(t.pool).Observe(time.Since(start).Seconds())
+	}
+}
+
+// end records the statement traced in ctx.
+func (t *tracer) end(ctx context.Context, operation string, err error) {
+	trace, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+
+	elapsed := time.Since(trace.start)
+
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+
+	queryDuration.WithLabelValues(t.pool, operation, status).Observe(elapsed.Seconds())
+
+	if t.slowQuery <= 0 || elapsed < t.slowQuery {
+		return
+	}
+
+	slowQueries.WithLabelValues(t.pool, operation).Inc()
+
+	t.logger.WarnContext(ctx, "Slow query",
+		"pool", t.pool,
+		"duration", elapsed,
+		"sql", strings.Join(strings.Fields(trace.sql), " "),
+		"args", sanitizeArgs(trace.args),
+		"error", err,
+	)
+}
+
+// sanitizeArgs - arguments of a statement as they are logged. Only numbers, booleans
+// and times are logged as they are; strings and binary values, which may carry tokens
+// or payloads, are replaced with their size, the rest with their type and length.
+func sanitizeArgs(args []any) []string {
+	sanitized := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		var s string
+
+		switch v := arg.(type) {
+		case nil:
+			s = "NULL"
+		case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, time.Time, time.Duration:
+			s = fmt.Sprint(v)
+		case string:
+			s = fmt.Sprintf("<string, %d bytes>", len(v))
+		case []byte:
+			s = fmt.Sprintf("<bytea, %d bytes>", len(v))
+		default:
+			switch rv := reflect.ValueOf(v); rv.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				s = fmt.Sprintf("<%T, %d items>", v, rv.Len())
+			default:
+				s = fmt.Sprintf("<%T>", v)
+			}
+		}
+
+		sanitized = append(sanitized, s)
+	}
+
+	return sanitized
+}
@@ -2,28 +2,371 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
 	"github.com/KVSH-user/ExchangeRateService/internal/models"
 )
 
-// SaveExchangeRate - method for save exchange rate to db
+// rateColumns - columns read by scanExchangeRate, in scan order
+const rateColumns = `id, market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id, valid_to`
+
+// rateCopyColumns - columns written by CopyExchangeRates, in rateCopyRow order
+var rateCopyColumns = []string{
+	"market", "ask_price", "bid_price", "ts", "source", "fetched_at", "latency_ms", "request_id", "book",
+}
+
+// insertRateQuery - inserts a rate from the rateCopyRow values
+const insertRateQuery = `
+	INSERT INTO rates (
+		market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id, book
+	) VALUES (
+		$1, $2, $3, $4, $5, $6, $7, $8, $9
+	) RETURNING id`
+
+// SaveExchangeRate - method for save exchange rate to db. In the write-behind mode
+// the rate is buffered and written later, while master is unreachable the rate is
+// kept in the rate queue; its ID is then left unset.
 func (s *Store) SaveExchangeRate(ctx context.Context, rate *models.ExchangeRate) error {
+	if s.writer != nil {
+		if err := s.writer.enqueue(ctx, rate); err != nil {
+			return fmt.Errorf("SaveExchangeRate: %w", err)
+		}
+
+		return nil
+	}
+
+	insert := func() error {
+		return s.insertExchangeRate(ctx, rate)
+	}
+
+	var err error
+
+	if s.queue != nil {
+		err = s.queue.write(ctx, []*models.ExchangeRate{rate}, insert)
+	} else {
+		err = insert()
+	}
+
+	if err != nil {
+		return fmt.Errorf("SaveExchangeRate: %w", err)
+	}
+
+	return nil
+}
+
+// insertExchangeRate - inserts the rate, closes the validity range of its predecessor and
+// notifies the other instances
+func (s *Store) insertExchangeRate(ctx context.Context, rate *models.ExchangeRate) error {
+	row, err := rateCopyRow(rate)
+	if err != nil {
+		return err
+	}
+
+	rates := []*models.ExchangeRate{rate}
+
+	return s.withRatePartitions(ctx, rates, func() error {
+		return s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+			if err := s.queryRow(ctx, insertRateQuery, tx, row...).Scan(&rate.ID); err != nil {
+				return err
+			}
+
+			if err := s.closeRateRanges(ctx, tx, rate.Market, rate.TS); err != nil {
+				return err
+			}
+
+			if err := s.insertRateEvents(ctx, tx, rates); err != nil {
+				return err
+			}
+
+			return s.notifyRates(ctx, tx, rates)
+		})
+	})
+}
+
+// flushExchangeRates - writes a batch of the write-behind mode with the copy protocol,
+// while master is unreachable the batch is kept in the rate queue
+func (s *Store) flushExchangeRates(ctx context.Context, rates []*models.ExchangeRate) (int64, error) {
+	if s.queue == nil {
+		return s.CopyExchangeRates(ctx, rates)
+	}
+
+	var n int64
+
+	err := s.queue.write(ctx, rates, func() error {
+		var err error
+
+		n, err = s.CopyExchangeRates(ctx, rates)
+
+		return err
+	})
+
+	return n, err
+}
+
+// CopyExchangeRates - method for save a batch of exchange rates to db using the copy protocol
+func (s *Store) CopyExchangeRates(ctx context.Context, rates []*models.ExchangeRate) (int64, error) {
+	// Earliest ts of the batch per market, the ranges are recomputed from there.
+	from := make(map[string]int64)
+	for _, rate := range rates {
+		if ts, ok := from[rate.Market]; !ok || rate.TS < ts {
+			from[rate.Market] = rate.TS
+		}
+	}
+
+	var n int64
+
+	err := s.withRatePartitions(ctx, rates, func() error {
+		return s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+			var err error
+
+			n, err = tx.CopyFrom(ctx, pgx.Identifier{"rates"}, rateCopyColumns, pgx.CopyFromSlice(len(rates), func(i int) ([]any, error) {
+				return rateCopyRow(rates[i])
+			}))
+			if err != nil {
+				return err
+			}
+
+			// Markets are locked in a fixed order, so that concurrent batches do not deadlock.
+			for _, market := range slices.Sorted(maps.Keys(from)) {
+				if err = s.closeRateRanges(ctx, tx, market, from[market]); err != nil {
+					return err
+				}
+			}
+
+			if err = s.insertRateEvents(ctx, tx, rates); err != nil {
+				return err
+			}
+
+			return s.notifyRates(ctx, tx, rates)
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("CopyExchangeRates: %w", err)
+	}
+
+	return n, nil
+}
+
+// closeRateRanges - sets valid_to of the snapshots of the market from the one in effect
+// before ts onwards to the ts of their successors. Writers of the same market are
+// serialized, so that concurrent inserts do not leave two open ranges.
+func (s *Store) closeRateRanges(ctx context.Context, tx pgclient.DB, market string, ts int64) error {
+	const lockQuery = `SELECT pg_advisory_xact_lock(hashtext('rates:' || $1))`
+
+	const query = `
+		UPDATE rates r
+		SET valid_to = n.next_ts
+		FROM (
+			SELECT id, ts, LEAD(ts) OVER (ORDER BY ts, id) AS next_ts
+			FROM rates
+			WHERE market = $1
+			  AND ts >= COALESCE((SELECT MAX(ts) FROM rates WHERE market = $1 AND ts < $2), $2)
+		) n
+		WHERE r.id = n.id
+		  AND r.ts = n.ts
+		  AND r.valid_to IS DISTINCT FROM n.next_ts`
+
+	if _, err := s.exec(ctx, lockQuery, tx, market); err != nil {
+		return err
+	}
+
+	_, err := s.exec(ctx, query, tx, market, ts)
+
+	return err
+}
+
+// GetLatestExchangeRate - method for get the stored exchange rate snapshot of the market
+// that is still in effect
+func (s *Store) GetLatestExchangeRate(ctx context.Context, market string) (*models.ExchangeRate, error) {
 	const query = `
-		INSERT INTO rates (
-			ask_price, bid_price, ts
-		) VALUES (
-			$1, $2, $3
-		) RETURNING id`
+		SELECT ` + rateColumns + `
+		FROM rates
+		WHERE market = $1
+		  AND valid_to IS NULL
+		ORDER BY ts DESC, id DESC
+		LIMIT 1`
+
+	var rate models.ExchangeRate
+
+	err := scanExchangeRate(s.queryRow(ctx, query, s.Master, market), &rate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrRateNotFound
+		}
+
+		return nil, fmt.Errorf("GetLatestExchangeRate: %w", err)
+	}
+
+	return &rate, nil
+}
+
+// GetExchangeRateAt - method for get the exchange rate snapshot of the market whose
+// validity range contains ts, taken not earlier than notBefore, from the history at the
+// resolution. Served by a replica when one is available.
+func (s *Store) GetExchangeRateAt(
+	ctx context.Context,
+	market string,
+	resolution models.Resolution,
+	ts, notBefore int64,
+) (*models.ExchangeRate, error) {
+	query := `
+		SELECT ` + rateColumns + `
+		FROM ` + historySource(resolution) + `
+		WHERE market = $1
+		  AND ts <= $2
+		  AND ts >= $3
+		  AND (valid_to IS NULL OR valid_to > $2)
+		ORDER BY ts DESC, id DESC
+		LIMIT 1`
+
+	var rate models.ExchangeRate
+
+	err := s.readTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		return scanExchangeRate(s.queryRow(ctx, query, tx, market, ts, notBefore), &rate)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrRateNotFound
+		}
+
+		return nil, fmt.Errorf("GetExchangeRateAt: %w", err)
+	}
+
+	return &rate, nil
+}
+
+// ListExchangeRates - method for list stored exchange rates of the market page by page,
+// ordered by (ts, id) and continuing after filter.After when it is set. Served by a replica
+// when one is available.
+func (s *Store) ListExchangeRates(ctx context.Context, filter models.RateFilter) ([]*models.ExchangeRate, error) {
+	queryAsc := `
+		SELECT ` + rateColumns + `
+		FROM ` + historySource(filter.Resolution) + `
+		WHERE market = $1
+		  AND ts >= $2
+		  AND ts < $3
+		  AND (market, ts, id) > ($1, $4, $5::BIGINT)
+		ORDER BY market, ts, id
+		LIMIT $6`
+
+	queryDesc := `
+		SELECT ` + rateColumns + `
+		FROM ` + historySource(filter.Resolution) + `
+		WHERE market = $1
+		  AND ts >= $2
+		  AND ts < $3
+		  AND (market, ts, id) < ($1, $4, $5::BIGINT)
+		ORDER BY market DESC, ts DESC, id DESC
+		LIMIT $6`
+
+	to := filter.To
+	if to == 0 {
+		to = math.MaxInt64
+	}
+
+	query := queryAsc
+	after := models.RateCursor{TS: math.MinInt64, ID: math.MinInt64}
 
-	err := s.queryRow(ctx, query, s.Master,
+	if filter.Desc {
+		query = queryDesc
+		after = models.RateCursor{TS: math.MaxInt64, ID: math.MaxInt64}
+	}
+
+	if filter.After != nil {
+		after = *filter.After
+	}
+
+	var rates []*models.ExchangeRate
+
+	err := s.readTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		rows, err := s.query(ctx, query, tx, filter.Market, filter.From, to, after.TS, after.ID, filter.Limit)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		rates = make([]*models.ExchangeRate, 0, filter.Limit)
+
+		for rows.Next() {
+			var rate models.ExchangeRate
+
+			if err = scanExchangeRate(rows, &rate); err != nil {
+				return err
+			}
+
+			rates = append(rates, &rate)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ListExchangeRates: %w", err)
+	}
+
+	return rates, nil
+}
+
+// rateCopyRow - values of the rate in rateCopyColumns order
+func rateCopyRow(rate *models.ExchangeRate) ([]any, error) {
+	var book []byte
+
+	if rate.Book != nil {
+		var err error
+
+		if book, err = json.Marshal(rate.Book); err != nil {
+			return nil, err
+		}
+	}
+
+	return []any{
+		rate.Market,
 		rate.AskPrice,
 		rate.BidPrice,
 		rate.TS,
-	).Scan(&rate.ID)
+		rate.Source,
+		rate.FetchedAt,
+		rate.Latency.Milliseconds(),
+		rate.RequestID,
+		book,
+	}, nil
+}
+
+// scanExchangeRate - scans a row selected with rateColumns into rate
+func scanExchangeRate(row pgx.Row, rate *models.ExchangeRate) error {
+	var (
+		latencyMs int64
+		validTo   *int64
+	)
 
+	err := row.Scan(
+		&rate.ID,
+		&rate.Market,
+		&rate.AskPrice,
+		&rate.BidPrice,
+		&rate.TS,
+		&rate.Source,
+		&rate.FetchedAt,
+		&latencyMs,
+		&rate.RequestID,
+		&validTo,
+	)
 	if err != nil {
-		return fmt.Errorf("SaveExchangeRate: %w", err)
+		return err
+	}
+
+	rate.Latency = time.Duration(latencyMs) * time.Millisecond
+
+	if validTo != nil {
+		rate.ValidTo = *validTo
 	}
 
 	return nil
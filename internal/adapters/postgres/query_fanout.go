@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+const (
+	// catchUpPageSize - number of rates read at once when catching up after a reconnection
+	catchUpPageSize = 1000
+	// maxNotifyPayload - longest payload accepted by pg_notify
+	maxNotifyPayload = 7999
+)
+
+// rateNotification - payload of a notification of a saved rate
+type rateNotification struct {
+	*models.ExchangeRate
+	// Ref is set when the rate did not fit into the notification, only its market,
+	// ts and id are sent then and the listeners read it from the table.
+	Ref bool `json:"ref,omitempty"`
+}
+
+// rateFanout - the fan-out channel of the saved rates and its listener
+type rateFanout struct {
+	channel       string
+	listener      *pgclient.Listener
+	catchUpWindow time.Duration
+}
+
+// notifyRates - sends a notification with every rate on the fan-out channel within tx,
+// the listeners receive them in order once tx commits. The order book is left out, a
+// payload must stay under 8000 bytes: a rate with a longer one is sent as a reference
+// to its row rather than failing the write.
+func (s *Store) notifyRates(ctx context.Context, tx pgclient.DB, rates []*models.ExchangeRate) error {
+	const query = `SELECT pg_notify($1, payload) FROM unnest($2::TEXT[]) WITH ORDINALITY AS p(payload, n) ORDER BY n`
+
+	if s.fanout == nil || len(rates) == 0 {
+		return nil
+	}
+
+	payloads := make([]string, 0, len(rates))
+
+	for _, rate := range rates {
+		payload, err := json.Marshal(rateNotification{ExchangeRate: rate})
+		if err != nil {
+			return err
+		}
+
+		if len(payload) > maxNotifyPayload {
+			rateFanoutRefNotifications.Inc()
+			s.logger.DebugContext(ctx, "rate notification is too large, sending a reference",
+				"market", rate.Market, "ts", rate.TS, "bytes", len(payload))
+
+			payload, err = json.Marshal(rateNotification{
+				ExchangeRate: &models.ExchangeRate{ID: rate.ID, Market: rate.Market, TS: rate.TS},
+				Ref:          true,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		payloads = append(payloads, string(payload))
+	}
+
+	_, err := s.exec(ctx, query, tx, s.fanout.channel, payloads)
+
+	return err
+}
+
+// ListenRates - method for pass the rates saved by every instance to handle until ctx
+// is done. The listener reconnects on its own; the rates saved since the last received
+// one, less the catch-up window, are then read from the table, as on startup the rates
+// of the last window. Handle may thus see a rate more than once, also with its ID unset
+// when it was saved in the write-behind mode. Does nothing when the fan-out is disabled.
+func (s *Store) ListenRates(ctx context.Context, handle func(rate *models.ExchangeRate)) {
+	if s.fanout == nil {
+		return
+	}
+
+	window := int64(s.fanout.catchUpWindow / time.Second)
+
+	// since - greatest ts received so far, only used by the listener goroutine.
+	var since int64
+
+	catchUp := func(ctx context.Context) error {
+		from := time.Now().Unix() - window
+		if since > 0 {
+			from = since - window
+		}
+
+		n, err := s.catchUpRates(ctx, from, func(rate *models.ExchangeRate) {
+			since = max(since, rate.TS)
+			handle(rate)
+		})
+		if err != nil {
+			return err
+		}
+
+		s.logger.InfoContext(ctx, "Caught up on saved rates", "from", from, "rates", n)
+
+		return nil
+	}
+
+	s.fanout.listener.Run(ctx, catchUp, func(ctx context.Context, payload string) {
+		var notification rateNotification
+
+		if err := json.Unmarshal([]byte(payload), &notification); err != nil || notification.ExchangeRate == nil {
+			s.logger.WarnContext(ctx, "failed to decode rate notification", "payload", payload, "error", err)
+
+			return
+		}
+
+		if !notification.Ref {
+			since = max(since, notification.TS)
+			handle(notification.ExchangeRate)
+
+			return
+		}
+
+		// Missed rates are read on the next catch-up, as after a lost notification.
+		err := s.notifiedRates(ctx, notification.ExchangeRate, func(rate *models.ExchangeRate) {
+			since = max(since, rate.TS)
+			handle(rate)
+		})
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to read notified rate", "market", notification.Market, "ts", notification.TS, "error", err)
+		}
+	})
+}
+
+// notifiedRates - passes the rates a reference notification was sent for to handle:
+// the rate with the id or, when it was saved without one in the write-behind mode,
+// the rates of the market at its ts. Reads master, a replica may not have them yet.
+func (s *Store) notifiedRates(ctx context.Context, ref *models.ExchangeRate, handle func(rate *models.ExchangeRate)) error {
+	const query = `
+		SELECT ` + rateColumns + `
+		FROM rates
+		WHERE market = $1
+		  AND ts = $2
+		  AND ($3::BIGINT = 0 OR id = $3::BIGINT)
+		ORDER BY id`
+
+	rows, err := s.query(ctx, query, s.Master, ref.Market, ref.TS, ref.ID)
+	if err != nil {
+		return fmt.Errorf("notifiedRates: %w", err)
+	}
+
+	rates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*models.ExchangeRate, error) {
+		var rate models.ExchangeRate
+
+		return &rate, scanExchangeRate(row, &rate)
+	})
+	if err != nil {
+		return fmt.Errorf("notifiedRates: %w", err)
+	}
+
+	for _, rate := range rates {
+		handle(rate)
+	}
+
+	return nil
+}
+
+// catchUpRates - passes the rates stored with a ts from from on to handle, ordered by
+// ts and id. Reads master, a replica may not have the latest ones yet. Returns the
+// number of rates.
+func (s *Store) catchUpRates(ctx context.Context, from int64, handle func(rate *models.ExchangeRate)) (int, error) {
+	const query = `
+		SELECT ` + rateColumns + `
+		FROM rates
+		WHERE ts >= $1
+		  AND (ts, id) > ($2, $3)
+		ORDER BY ts, id
+		LIMIT $4`
+
+	var (
+		n      int
+		cursor = models.RateCursor{TS: from - 1}
+	)
+
+	for {
+		rows, err := s.query(ctx, query, s.Master, from, cursor.TS, cursor.ID, catchUpPageSize)
+		if err != nil {
+			return n, fmt.Errorf("catchUpRates: %w", err)
+		}
+
+		page, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*models.ExchangeRate, error) {
+			var rate models.ExchangeRate
+
+			return &rate, scanExchangeRate(row, &rate)
+		})
+		if err != nil {
+			return n, fmt.Errorf("catchUpRates: %w", err)
+		}
+
+		for _, rate := range page {
+			handle(rate)
+		}
+
+		n += len(page)
+
+		if len(page) < catchUpPageSize {
+			return n, nil
+		}
+
+		last := page[len(page)-1]
+		cursor = models.RateCursor{TS: last.TS, ID: last.ID}
+	}
+}
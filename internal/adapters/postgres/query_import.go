@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// errDryRun rolls the transaction of a dry run back.
+var errDryRun = errors.New("dry run")
+
+// ImportExchangeRates - method for load a batch of historical exchange rates in one transaction,
+// copying them into a temporary table first. A rate is skipped when its market already has a
+// rate stored at its ts; the indexes of the skipped rates in rates are returned. The validity
+// ranges are recomputed and the aggregates rolled up again, no rate events or notifications
+// are sent. With dryRun nothing is stored, though the partitions missing for the rates
+// are still created.
+func (s *Store) ImportExchangeRates(ctx context.Context, rates []*models.ExchangeRate, dryRun bool) ([]int, error) {
+	const createQuery = `
+		CREATE TEMP TABLE rates_import ON COMMIT DROP AS
+		SELECT 0::INT AS idx, market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id
+		FROM rates
+		WITH NO DATA`
+
+	const storedQuery = `
+		SELECT idx FROM rates_import i
+		WHERE EXISTS (SELECT 1 FROM rates r WHERE r.market = i.market AND r.ts = i.ts)
+		ORDER BY idx`
+
+	const insertQuery = `
+		INSERT INTO rates (market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id)
+		SELECT market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id
+		FROM rates_import
+		WHERE idx <> ALL($1)
+		ORDER BY ts, idx`
+
+	importColumns := []string{
+		"idx", "market", "ask_price", "bid_price", "ts", "source", "fetched_at", "latency_ms", "request_id",
+	}
+
+	var stored []int
+
+	err := s.withRatePartitions(ctx, rates, func() error {
+		return s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+			if _, err := s.exec(ctx, createQuery, tx); err != nil {
+				return err
+			}
+
+			_, err := tx.CopyFrom(ctx, pgx.Identifier{"rates_import"}, importColumns, pgx.CopyFromSlice(len(rates), func(i int) ([]any, error) {
+				rate := rates[i]
+
+				return []any{
+					i,
+					rate.Market,
+					rate.AskPrice,
+					rate.BidPrice,
+					rate.TS,
+					rate.Source,
+					rate.FetchedAt,
+					rate.Latency.Milliseconds(),
+					rate.RequestID,
+				}, nil
+			}))
+			if err != nil {
+				return err
+			}
+
+			rows, err := s.query(ctx, storedQuery, tx)
+			if err != nil {
+				return err
+			}
+
+			stored, err = pgx.CollectRows(rows, pgx.RowTo[int])
+			if err != nil {
+				return err
+			}
+
+			if _, err = s.exec(ctx, insertQuery, tx, stored); err != nil {
+				return err
+			}
+
+			// Earliest imported ts per market, the ranges are recomputed from there.
+			from := make(map[string]int64)
+
+			for i, rate := range rates {
+				if _, ok := slices.BinarySearch(stored, i); ok {
+					continue
+				}
+
+				if ts, ok := from[rate.Market]; !ok || rate.TS < ts {
+					from[rate.Market] = rate.TS
+				}
+			}
+
+			if len(from) > 0 {
+				// Markets are locked in a fixed order, so that concurrent batches do not deadlock.
+				for _, market := range slices.Sorted(maps.Keys(from)) {
+					if err = s.closeRateRanges(ctx, tx, market, from[market]); err != nil {
+						return err
+					}
+				}
+
+				if err = s.rewindRollups(ctx, tx, slices.Min(slices.Collect(maps.Values(from)))); err != nil {
+					return err
+				}
+			}
+
+			if dryRun {
+				return errDryRun
+			}
+
+			return nil
+		})
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, fmt.Errorf("ImportExchangeRates: %w", err)
+	}
+
+	return stored, nil
+}
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// marketColumns - columns read by scanMarket, in scan order
+const marketColumns = `symbol, base_currency, quote_currency, provider, price_precision, rounding,
+	polling_interval_seconds, staleness_limit_seconds, enabled, store_mode, heartbeat_interval_seconds,
+	created_at, updated_at`
+
+// ListMarkets - method for list all registered markets
+func (s *Store) ListMarkets(ctx context.Context) ([]*models.Market, error) {
+	const query = `
+		SELECT ` + marketColumns + `
+		FROM markets
+		ORDER BY symbol`
+
+	rows, err := s.query(ctx, query, s.Master)
+	if err != nil {
+		return nil, fmt.Errorf("ListMarkets: %w", err)
+	}
+	defer rows.Close()
+
+	var markets []*models.Market
+
+	for rows.Next() {
+		var market models.Market
+
+		if err = scanMarket(rows, &market); err != nil {
+			return nil, fmt.Errorf("ListMarkets: %w", err)
+		}
+
+		markets = append(markets, &market)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListMarkets: %w", err)
+	}
+
+	return markets, nil
+}
+
+// GetMarket - method for get a registered market by symbol
+func (s *Store) GetMarket(ctx context.Context, symbol string) (*models.Market, error) {
+	const query = `
+		SELECT ` + marketColumns + `
+		FROM markets
+		WHERE symbol = $1`
+
+	var market models.Market
+
+	err := scanMarket(s.queryRow(ctx, query, s.Master, symbol), &market)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrMarketNotFound
+		}
+
+		return nil, fmt.Errorf("GetMarket: %w", err)
+	}
+
+	return &market, nil
+}
+
+// UpsertMarket - method for register a market or replace its settings
+func (s *Store) UpsertMarket(ctx context.Context, market *models.Market) error {
+	const query = `
+		INSERT INTO markets (
+			symbol, base_currency, quote_currency, provider, price_precision, rounding,
+			polling_interval_seconds, staleness_limit_seconds, enabled, store_mode, heartbeat_interval_seconds
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+		ON CONFLICT (symbol) DO UPDATE SET
+			base_currency = EXCLUDED.base_currency,
+			quote_currency = EXCLUDED.quote_currency,
+			provider = EXCLUDED.provider,
+			price_precision = EXCLUDED.price_precision,
+			rounding = EXCLUDED.rounding,
+			polling_interval_seconds = EXCLUDED.polling_interval_seconds,
+			staleness_limit_seconds = EXCLUDED.staleness_limit_seconds,
+			enabled = EXCLUDED.enabled,
+			store_mode = EXCLUDED.store_mode,
+			heartbeat_interval_seconds = EXCLUDED.heartbeat_interval_seconds,
+			updated_at = NOW()
+		RETURNING created_at, updated_at`
+
+	err := s.queryRow(ctx, query, s.Master, marketArgs(market)...).Scan(&market.CreatedAt, &market.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("UpsertMarket: %w", err)
+	}
+
+	return nil
+}
+
+// SetMarketEnabled - method for enable or disable a registered market
+func (s *Store) SetMarketEnabled(ctx context.Context, symbol string, enabled bool) (*models.Market, error) {
+	const query = `
+		UPDATE markets
+		SET enabled = $2, updated_at = NOW()
+		WHERE symbol = $1
+		RETURNING ` + marketColumns
+
+	var market models.Market
+
+	err := scanMarket(s.queryRow(ctx, query, s.Master, symbol, enabled), &market)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrMarketNotFound
+		}
+
+		return nil, fmt.Errorf("SetMarketEnabled: %w", err)
+	}
+
+	return &market, nil
+}
+
+// SeedMarkets - method for register the markets that are not registered yet,
+// settings of the already registered ones are kept
+func (s *Store) SeedMarkets(ctx context.Context, markets []*models.Market) error {
+	const query = `
+		INSERT INTO markets (
+			symbol, base_currency, quote_currency, provider, price_precision, rounding,
+			polling_interval_seconds, staleness_limit_seconds, enabled, store_mode, heartbeat_interval_seconds
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+		ON CONFLICT (symbol) DO NOTHING`
+
+	err := s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		for _, market := range markets {
+			if _, err := s.exec(ctx, query, tx, marketArgs(market)...); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("SeedMarkets: %w", err)
+	}
+
+	return nil
+}
+
+// marketArgs - insert arguments of the market, in the column order of the insert queries
+func marketArgs(market *models.Market) []any {
+	return []any{
+		market.Symbol,
+		market.BaseCurrency,
+		market.QuoteCurrency,
+		market.Provider,
+		market.Precision,
+		string(market.Rounding),
+		int64(market.PollingInterval / time.Second),
+		int64(market.StalenessLimit / time.Second),
+		market.Enabled,
+		string(market.StoreMode),
+		int64(market.HeartbeatInterval / time.Second),
+	}
+}
+
+// scanMarket - scans a row selected with marketColumns into market
+func scanMarket(row pgx.Row, market *models.Market) error {
+	var (
+		rounding, storeMode                                string
+		pollingInterval, stalenessLimit, heartbeatInterval int64
+	)
+
+	err := row.Scan(
+		&market.Symbol,
+		&market.BaseCurrency,
+		&market.QuoteCurrency,
+		&market.Provider,
+		&market.Precision,
+		&rounding,
+		&pollingInterval,
+		&stalenessLimit,
+		&market.Enabled,
+		&storeMode,
+		&heartbeatInterval,
+		&market.CreatedAt,
+		&market.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	market.Rounding = models.RoundingMode(rounding)
+	market.PollingInterval = time.Duration(pollingInterval) * time.Second
+	market.StalenessLimit = time.Duration(stalenessLimit) * time.Second
+	market.StoreMode = models.StoreMode(storeMode)
+	market.HeartbeatInterval = time.Duration(heartbeatInterval) * time.Second
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// GetOrderBookAt - method for get the order book of the market snapshot whose validity
+// range contains ts, taken not earlier than notBefore. Served by a replica when one is
+// available.
+func (s *Store) GetOrderBookAt(ctx context.Context, market string, ts, notBefore int64) (*models.OrderBook, error) {
+	const query = `
+		SELECT ts, book
+		FROM rates
+		WHERE market = $1
+		  AND ts <= $2
+		  AND ts >= $3
+		  AND (valid_to IS NULL OR valid_to > $2)
+		ORDER BY ts DESC, id DESC
+		LIMIT 1`
+
+	var (
+		book    = models.OrderBook{Market: market}
+		payload []byte
+	)
+
+	err := s.readTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		return s.queryRow(ctx, query, tx, market, ts, notBefore).Scan(&book.TS, &payload)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrOrderBookNotFound
+		}
+
+		return nil, fmt.Errorf("GetOrderBookAt: %w", err)
+	}
+
+	// The snapshot was stored without its book.
+	if payload == nil {
+		return nil, models.ErrOrderBookNotFound
+	}
+
+	if err = json.Unmarshal(payload, &book); err != nil {
+		return nil, fmt.Errorf("GetOrderBookAt: %w", err)
+	}
+
+	return &book, nil
+}
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// outboxCopyColumns - columns written by insertRateEvents, in the row order
+var outboxCopyColumns = []string{"type", "market", "payload"}
+
+// insertRateEvents - writes a RateSavedEvent per rate to the outbox within tx. Must run
+// after closeRateRanges, so that the events of a market get ids in the commit order.
+func (s *Store) insertRateEvents(ctx context.Context, tx pgclient.DB, rates []*models.ExchangeRate) error {
+	if !s.outbox {
+		return nil
+	}
+
+	rows := make([][]any, 0, len(rates))
+
+	for _, rate := range rates {
+		payload, err := json.Marshal(rate)
+		if err != nil {
+			return err
+		}
+
+		rows = append(rows, []any{models.RateSavedEvent, rate.Market, payload})
+	}
+
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{"rate_outbox"}, outboxCopyColumns, pgx.CopyFromRows(rows))
+
+	return err
+}
+
+// DeliverOutbox - method for pass up to limit pending events ordered by id to deliver and
+// record the outcome: the delivered ones are marked, the failed ones get their attempt and
+// error counted and are dead-lettered once they failed maxAttempts times, the rest is left
+// pending. Only one relay delivers at a time, ok is false
+// when another one holds the outbox. The relay holds a session lock rather than a
+// transaction while deliver runs: the batch is read, delivered and then recorded in a
+// transaction of its own, so a slow publisher keeps no transaction open.
+func (s *Store) DeliverOutbox(
+	ctx context.Context,
+	limit, maxAttempts int,
+	deliver func(ctx context.Context, events []*models.OutboxEvent) (delivered []int64, failed map[int64]error),
+) (n int, ok bool, err error) {
+	ok, err = s.Master.WithTryAdvisoryLock(ctx, "rate_outbox", func(ctx context.Context) error {
+		events, err := s.pendingOutbox(ctx, limit)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		delivered, failed := deliver(ctx, events)
+
+		// The events are published already, their outcome is recorded even when ctx is done.
+		if err = s.recordOutbox(context.WithoutCancel(ctx), delivered, failed, maxAttempts); err != nil {
+			return err
+		}
+
+		n = len(delivered)
+
+		return nil
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("DeliverOutbox: %w", err)
+	}
+
+	return n, ok, nil
+}
+
+// pendingOutbox - returns up to limit pending events ordered by id.
+func (s *Store) pendingOutbox(ctx context.Context, limit int) ([]*models.OutboxEvent, error) {
+	const query = `
+		SELECT id, type, market, payload, created_at, attempts
+		FROM rate_outbox
+		WHERE delivered_at IS NULL
+		  AND dead_at IS NULL
+		ORDER BY id
+		LIMIT $1`
+
+	rows, err := s.query(ctx, query, s.Master, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*models.OutboxEvent, error) {
+		var (
+			event   models.OutboxEvent
+			payload []byte
+		)
+
+		if err := row.Scan(&event.ID, &event.Type, &event.Market, &payload, &event.CreatedAt, &event.Attempts); err != nil {
+			return nil, err
+		}
+
+		return &event, json.Unmarshal(payload, &event.Rate)
+	})
+}
+
+// recordOutbox - marks the delivered events and counts the attempt of the failed ones,
+// dead-lettering those that failed maxAttempts times.
+func (s *Store) recordOutbox(ctx context.Context, delivered []int64, failed map[int64]error, maxAttempts int) error {
+	const deliveredQuery = `UPDATE rate_outbox SET delivered_at = NOW() WHERE id = ANY($1)`
+
+	const failedQuery = `
+		UPDATE rate_outbox
+		SET attempts = attempts + 1,
+		    last_error = $2,
+		    dead_at = CASE WHEN attempts + 1 >= $3 THEN NOW() END
+		WHERE id = $1
+		RETURNING dead_at IS NOT NULL`
+
+	var dead int
+
+	err := s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		dead = 0
+
+		if _, err := s.exec(ctx, deliveredQuery, tx, delivered); err != nil {
+			return err
+		}
+
+		for id, deliverErr := range failed {
+			var isDead bool
+
+			if err := s.queryRow(ctx, failedQuery, tx, id, deliverErr.Error(), maxAttempts).Scan(&isDead); err != nil {
+				return err
+			}
+
+			if isDead {
+				dead++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	outboxDeadEvents.Add(float64(dead))
+
+	return nil
+}
+
+// PurgeDeliveredOutbox - method for delete the events delivered before before.
+// Returns the number of deleted events.
+func (s *Store) PurgeDeliveredOutbox(ctx context.Context, before time.Time) (int64, error) {
+	const query = `DELETE FROM rate_outbox WHERE delivered_at < $1`
+
+	tag, err := s.exec(ctx, query, s.Master, before)
+	if err != nil {
+		return 0, fmt.Errorf("PurgeDeliveredOutbox: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+const (
+	overrideActionSet   = "set"
+	overrideActionClear = "clear"
+)
+
+// overrideColumns - columns read by scanRateOverride, in scan order
+const overrideColumns = `market, ask_price, bid_price, reason, actor, expires_at, created_at`
+
+// ListRateOverrides - method for list the rate overrides that are not expired
+func (s *Store) ListRateOverrides(ctx context.Context) ([]*models.RateOverride, error) {
+	const query = `
+		SELECT ` + overrideColumns + `
+		FROM rate_overrides
+		WHERE expires_at IS NULL OR expires_at > NOW()
+		ORDER BY market`
+
+	rows, err := s.query(ctx, query, s.Master)
+	if err != nil {
+		return nil, fmt.Errorf("ListRateOverrides: %w", err)
+	}
+	defer rows.Close()
+
+	var overrides []*models.RateOverride
+
+	for rows.Next() {
+		var override models.RateOverride
+
+		if err = scanRateOverride(rows, &override); err != nil {
+			return nil, fmt.Errorf("ListRateOverrides: %w", err)
+		}
+
+		overrides = append(overrides, &override)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListRateOverrides: %w", err)
+	}
+
+	return overrides, nil
+}
+
+// SetRateOverride - method for set or replace the rate override of the market,
+// the change is recorded in the audit table in the same transaction
+func (s *Store) SetRateOverride(ctx context.Context, override *models.RateOverride) error {
+	const query = `
+		INSERT INTO rate_overrides (
+			market, ask_price, bid_price, reason, actor, expires_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		)
+		ON CONFLICT (market) DO UPDATE SET
+			ask_price = EXCLUDED.ask_price,
+			bid_price = EXCLUDED.bid_price,
+			reason = EXCLUDED.reason,
+			actor = EXCLUDED.actor,
+			expires_at = EXCLUDED.expires_at,
+			created_at = NOW()
+		RETURNING created_at`
+
+	err := s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		err := s.queryRow(ctx, query, tx,
+			override.Market,
+			override.AskPrice,
+			override.BidPrice,
+			override.Reason,
+			override.Actor,
+			nullTime(override.ExpiresAt),
+		).Scan(&override.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		return s.auditRateOverride(ctx, tx, overrideActionSet, override)
+	})
+	if err != nil {
+		return fmt.Errorf("SetRateOverride: %w", err)
+	}
+
+	return nil
+}
+
+// ClearRateOverride - method for remove the rate override of the market on behalf of
+// actor, the change is recorded in the audit table in the same transaction
+func (s *Store) ClearRateOverride(ctx context.Context, market, actor, reason string) error {
+	const query = `
+		DELETE FROM rate_overrides
+		WHERE market = $1
+		RETURNING ` + overrideColumns
+
+	err := s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		var override models.RateOverride
+
+		if err := scanRateOverride(s.queryRow(ctx, query, tx, market), &override); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrOverrideNotFound
+			}
+
+			return err
+		}
+
+		override.Actor = actor
+		override.Reason = reason
+
+		return s.auditRateOverride(ctx, tx, overrideActionClear, &override)
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrOverrideNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("ClearRateOverride: %w", err)
+	}
+
+	return nil
+}
+
+// auditRateOverride - records a change of the rate override in the audit table
+func (s *Store) auditRateOverride(ctx context.Context, tx pgclient.DB, action string, override *models.RateOverride) error {
+	const query = `
+		INSERT INTO rate_override_audit (
+			market, action, ask_price, bid_price, reason, actor, expires_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)`
+
+	_, err := s.exec(ctx, query, tx,
+		override.Market,
+		action,
+		override.AskPrice,
+		override.BidPrice,
+		override.Reason,
+		override.Actor,
+		nullTime(override.ExpiresAt),
+	)
+
+	return err
+}
+
+// scanRateOverride - scans a row selected with overrideColumns into override
+func scanRateOverride(row pgx.Row, override *models.RateOverride) error {
+	var expiresAt *time.Time
+
+	err := row.Scan(
+		&override.Market,
+		&override.AskPrice,
+		&override.BidPrice,
+		&override.Reason,
+		&override.Actor,
+		&expiresAt,
+		&override.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if expiresAt != nil {
+		override.ExpiresAt = *expiresAt
+	}
+
+	return nil
+}
+
+// nullTime - NULL for the zero time
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
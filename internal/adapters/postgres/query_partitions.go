@@ -0,0 +1,274 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+const (
+	// checkViolation - SQLSTATE of a row that fits no partition, among other check failures
+	checkViolation = "23514"
+	// duplicateTable, uniqueViolation - SQLSTATEs of a table created concurrently
+	duplicateTable  = "42P07"
+	uniqueViolation = "23505"
+)
+
+// ratePartitionBound - matches the bound of a range partition as printed by pg_get_expr
+var ratePartitionBound = regexp.MustCompile(`FROM \(([^)]+)\) TO \(([^)]+)\)`)
+
+// ListRatePartitions - method for list the partitions of the rates table ordered by range
+func (s *Store) ListRatePartitions(ctx context.Context) ([]*models.RatePartition, error) {
+	const query = `
+		SELECT c.relname, pg_get_expr(c.relpartbound, c.oid)
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'rates'::regclass`
+
+	rows, err := s.query(ctx, query, s.Master)
+	if err != nil {
+		return nil, fmt.Errorf("ListRatePartitions: %w", err)
+	}
+	defer rows.Close()
+
+	var partitions []*models.RatePartition
+
+	for rows.Next() {
+		var name, bound string
+
+		if err = rows.Scan(&name, &bound); err != nil {
+			return nil, fmt.Errorf("ListRatePartitions: %w", err)
+		}
+
+		partition, err := parseRatePartition(name, bound)
+		if err != nil {
+			return nil, fmt.Errorf("ListRatePartitions: %w", err)
+		}
+
+		partitions = append(partitions, partition)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListRatePartitions: %w", err)
+	}
+
+	slices.SortFunc(partitions, func(a, b *models.RatePartition) int {
+		return cmp.Compare(a.From, b.From)
+	})
+
+	return partitions, nil
+}
+
+// WithRetentionLock - method for run f unless the retention is run by another instance,
+// ok reports whether f ran
+func (s *Store) WithRetentionLock(ctx context.Context, f func(ctx context.Context) error) (bool, error) {
+	ok, err := s.Master.WithTryAdvisoryLock(ctx, "rate_retention", f)
+	if err != nil {
+		return ok, fmt.Errorf("WithRetentionLock: %w", err)
+	}
+
+	return ok, nil
+}
+
+// CreateRatePartition - method for create the daily partition of the rates table starting at day
+func (s *Store) CreateRatePartition(ctx context.Context, day time.Time) (*models.RatePartition, error) {
+	partition := &models.RatePartition{
+		Name: "rates_p" + day.UTC().Format("20060102"),
+		From: day.Unix(),
+		To:   day.AddDate(0, 0, 1).Unix(),
+	}
+
+	query := fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS %s PARTITION OF rates FOR VALUES FROM (%d) TO (%d)`,
+		pgx.Identifier{partition.Name}.Sanitize(), partition.From, partition.To,
+	)
+
+	if _, err := s.exec(ctx, query, s.Master); err != nil {
+		return nil, fmt.Errorf("CreateRatePartition: %w", err)
+	}
+
+	return partition, nil
+}
+
+// withRatePartitions - runs write, which stores rates in one transaction. When some of
+// them fall in a day that has no partition, e.g. beyond the premade days or in a day the
+// retention has removed, the missing daily partitions are created and write runs again.
+func (s *Store) withRatePartitions(ctx context.Context, rates []*models.ExchangeRate, write func() error) error {
+	err := write()
+	if !missingRatePartition(err) {
+		return err
+	}
+
+	if err = s.createRatePartitionsFor(ctx, rates); err != nil {
+		return fmt.Errorf("%w: %w", models.ErrNoRatePartition, err)
+	}
+
+	err = write()
+	if missingRatePartition(err) {
+		return fmt.Errorf("%w: %w", models.ErrNoRatePartition, err)
+	}
+
+	return err
+}
+
+// createRatePartitionsFor - creates the daily partitions of the days of the rates that
+// no partition covers
+func (s *Store) createRatePartitionsFor(ctx context.Context, rates []*models.ExchangeRate) error {
+	partitions, err := s.ListRatePartitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	const daySeconds = 24 * 60 * 60
+
+	days := make(map[int64]struct{})
+
+	for _, rate := range rates {
+		from := rate.TS - ((rate.TS%daySeconds)+daySeconds)%daySeconds
+		days[from] = struct{}{}
+	}
+
+	for from := range days {
+		covered := slices.ContainsFunc(partitions, func(p *models.RatePartition) bool {
+			return p.Overlaps(from, from+daySeconds)
+		})
+		if covered {
+			continue
+		}
+
+		partition, err := s.CreateRatePartition(ctx, time.Unix(from, 0))
+		if err != nil {
+			var pgErr *pgconn.PgError
+
+			// Another instance has just created it.
+			if errors.As(err, &pgErr) && (pgErr.Code == duplicateTable || pgErr.Code == uniqueViolation) {
+				continue
+			}
+
+			return err
+		}
+
+		s.logger.WarnContext(ctx, "rate partition created for rates outside the premade days", "partition", partition.Name)
+	}
+
+	return nil
+}
+
+// missingRatePartition reports whether err means a rate fits no partition of rates.
+func missingRatePartition(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) &&
+		pgErr.Code == checkViolation &&
+		strings.HasPrefix(pgErr.Message, "no partition of relation")
+}
+
+// DropRatePartition - method for remove the partition from the rates table, the archived
+// partitions are kept in the rates_archive schema
+func (s *Store) DropRatePartition(ctx context.Context, name string, archive bool) error {
+	table := pgx.Identifier{name}.Sanitize()
+
+	err := s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		if !archive {
+			_, err := s.exec(ctx, `DROP TABLE `+table, tx)
+
+			return err
+		}
+
+		if _, err := s.exec(ctx, `ALTER TABLE rates DETACH PARTITION `+table, tx); err != nil {
+			return err
+		}
+
+		_, err := s.exec(ctx, `ALTER TABLE `+table+` SET SCHEMA rates_archive`, tx)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("DropRatePartition: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeExpiredRates - method for delete the rates with ts before the given one of the
+// markets, or of every other market when except is set. The archived rows are moved
+// to rates_archive.rates_expired.
+func (s *Store) PurgeExpiredRates(ctx context.Context, markets []string, except bool, before int64, archive bool) (int64, error) {
+	const (
+		deleteQuery = `
+			DELETE FROM rates
+			WHERE (market = ANY($1)) <> $2
+			  AND ts < $3`
+
+		archiveQuery = `
+			WITH expired AS (
+				DELETE FROM rates
+				WHERE (market = ANY($1)) <> $2
+				  AND ts < $3
+				RETURNING *
+			)
+			INSERT INTO rates_archive.rates_expired
+			SELECT * FROM expired`
+	)
+
+	if markets == nil {
+		// A NULL array would match no market at all.
+		markets = []string{}
+	}
+
+	query := deleteQuery
+	if archive {
+		query = archiveQuery
+	}
+
+	tag, err := s.exec(ctx, query, s.Master, markets, except, before)
+	if err != nil {
+		return 0, fmt.Errorf("PurgeExpiredRates: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// parseRatePartition - builds the partition from its name and bound expression
+func parseRatePartition(name, bound string) (*models.RatePartition, error) {
+	match := ratePartitionBound.FindStringSubmatch(bound)
+	if match == nil {
+		return nil, fmt.Errorf("unexpected bound %q of partition %s", bound, name)
+	}
+
+	from, err := parseRangeBound(match[1], math.MinInt64)
+	if err != nil {
+		return nil, fmt.Errorf("partition %s: %w", name, err)
+	}
+
+	to, err := parseRangeBound(match[2], math.MaxInt64)
+	if err != nil {
+		return nil, fmt.Errorf("partition %s: %w", name, err)
+	}
+
+	return &models.RatePartition{Name: name, From: from, To: to}, nil
+}
+
+// parseRangeBound - parses a single range bound, MINVALUE and MAXVALUE map to unbounded
+func parseRangeBound(bound string, unbounded int64) (int64, error) {
+	bound = strings.Trim(bound, "' ")
+
+	if bound == "MINVALUE" || bound == "MAXVALUE" {
+		return unbounded, nil
+	}
+
+	return strconv.ParseInt(bound, 10, 64)
+}
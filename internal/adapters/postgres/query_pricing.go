@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// ListPricingRules - method for list the enabled pricing rules of all clients
+func (s *Store) ListPricingRules(ctx context.Context) ([]*models.PricingRule, error) {
+	const query = `
+		SELECT id, client_id, market, min_amount, markup_bps, min_fee, enabled, created_at, updated_at
+		FROM pricing_rules
+		WHERE enabled
+		ORDER BY client_id, market, min_amount`
+
+	rows, err := s.query(ctx, query, s.Master)
+	if err != nil {
+		return nil, fmt.Errorf("ListPricingRules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*models.PricingRule
+
+	for rows.Next() {
+		var rule models.PricingRule
+
+		err = rows.Scan(
+			&rule.ID,
+			&rule.ClientID,
+			&rule.Market,
+			&rule.MinAmount,
+			&rule.MarkupBps,
+			&rule.MinFee,
+			&rule.Enabled,
+			&rule.CreatedAt,
+			&rule.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("ListPricingRules: %w", err)
+		}
+
+		rules = append(rules, &rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListPricingRules: %w", err)
+	}
+
+	return rules, nil
+}
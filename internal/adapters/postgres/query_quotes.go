@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+const (
+	// serializationFailure - SQLSTATE of a serializable transaction that lost a conflict
+	serializationFailure = "40001"
+	// redeemAttempts - attempts of a redeem transaction failed by serialization conflicts
+	redeemAttempts = 3
+)
+
+// quoteColumns - columns read by scanQuote, in scan order
+const quoteColumns = `id, client_id, market, amount, ask_price, bid_price, raw_ask_price, raw_bid_price,
+	pricing_rule_id, rate_ts, created_at, expires_at, redeemed_at`
+
+// SaveQuote - method for save a new quote to db
+func (s *Store) SaveQuote(ctx context.Context, quote *models.Quote) error {
+	const query = `
+		INSERT INTO quotes (
+			id, client_id, market, amount, ask_price, bid_price, raw_ask_price, raw_bid_price,
+			pricing_rule_id, rate_ts, expires_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		) RETURNING created_at`
+
+	err := s.queryRow(ctx, query, s.Master,
+		quote.ID,
+		quote.ClientID,
+		quote.Market,
+		quote.Amount,
+		quote.AskPrice,
+		quote.BidPrice,
+		quote.RawAskPrice,
+		quote.RawBidPrice,
+		quote.PricingRuleID,
+		quote.RateTS,
+		quote.ExpiresAt,
+	).Scan(&quote.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("SaveQuote: %w", err)
+	}
+
+	return nil
+}
+
+// RedeemQuote - method for mark the quote of the client as redeemed. The check and the
+// update run in one serializable transaction, so a quote is redeemed at most once.
+func (s *Store) RedeemQuote(ctx context.Context, id, clientID string) (*models.Quote, error) {
+	const selectQuery = `
+		SELECT ` + quoteColumns + `
+		FROM quotes
+		WHERE id = $1`
+
+	const updateQuery = `
+		UPDATE quotes
+		SET redeemed_at = NOW()
+		WHERE id = $1
+		RETURNING redeemed_at`
+
+	var quote models.Quote
+
+	redeem := func(ctx context.Context, tx pgclient.DB) error {
+		err := scanQuote(s.queryRow(ctx, selectQuery, tx, id), &quote)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return models.ErrQuoteNotFound
+			}
+
+			return err
+		}
+
+		switch {
+		case quote.ClientID != clientID:
+			return models.ErrQuoteNotFound
+		case !quote.RedeemedAt.IsZero():
+			return models.ErrQuoteRedeemed
+		case !quote.ExpiresAt.After(time.Now()):
+			return models.ErrQuoteExpired
+		}
+
+		return s.queryRow(ctx, updateQuery, tx, id).Scan(&quote.RedeemedAt)
+	}
+
+	var err error
+
+	for range redeemAttempts {
+		err = s.Master.ExecTx(ctx, redeem, &pgclient.TxParamsIsoLevel{TxIsoLevel: pgx.Serializable})
+
+		var pgErr *pgconn.PgError
+		if !errors.As(err, &pgErr) || pgErr.Code != serializationFailure {
+			break
+		}
+	}
+
+	if err != nil {
+		if errors.Is(err, models.ErrQuoteNotFound) ||
+			errors.Is(err, models.ErrQuoteRedeemed) ||
+			errors.Is(err, models.ErrQuoteExpired) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("RedeemQuote: %w", err)
+	}
+
+	return &quote, nil
+}
+
+// scanQuote - scans a row selected with quoteColumns into quote
+func scanQuote(row pgx.Row, quote *models.Quote) error {
+	var redeemedAt *time.Time
+
+	err := row.Scan(
+		&quote.ID,
+		&quote.ClientID,
+		&quote.Market,
+		&quote.Amount,
+		&quote.AskPrice,
+		&quote.BidPrice,
+		&quote.RawAskPrice,
+		&quote.RawBidPrice,
+		&quote.PricingRuleID,
+		&quote.RateTS,
+		&quote.CreatedAt,
+		&quote.ExpiresAt,
+		&redeemedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if redeemedAt != nil {
+		quote.RedeemedAt = *redeemedAt
+	}
+
+	return nil
+}
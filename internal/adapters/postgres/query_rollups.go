@@ -0,0 +1,222 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// rollupTables - aggregate table of every rolled up resolution
+var rollupTables = map[models.Resolution]string{
+	models.ResolutionMinute: "rates_1m",
+	models.ResolutionHour:   "rates_1h",
+}
+
+// rollupColumns - columns of the aggregates selected as rateColumns, the close of
+// the bucket stands for the bucket
+const rollupColumns = `0::BIGINT, market, ask_close, bid_close, last_ts, source, fetched_at, 0::BIGINT, '', NULL::BIGINT`
+
+// rollupCheckpoint - subquery of the rolled_to of the resolution
+func rollupCheckpoint(resolution models.Resolution) string {
+	return `(SELECT rolled_to FROM rollup_checkpoints WHERE resolution = '` + string(resolution) + `')`
+}
+
+// historySource - relation with rateColumns holding the rate history at the resolution.
+// The aggregates are complemented with the finer history that is not rolled up yet,
+// so the recent rates are never missing.
+func historySource(resolution models.Resolution) string {
+	raw := `SELECT ` + rateColumns + ` FROM rates`
+
+	switch resolution {
+	case models.ResolutionMinute:
+		return `(
+			SELECT ` + rollupColumns + ` FROM rates_1m WHERE bucket < ` + rollupCheckpoint(models.ResolutionMinute) + `
+			UNION ALL
+			` + raw + ` WHERE ts >= ` + rollupCheckpoint(models.ResolutionMinute) + `
+		) AS history (` + rateColumns + `)`
+	case models.ResolutionHour:
+		return `(
+			SELECT ` + rollupColumns + ` FROM rates_1h WHERE bucket < ` + rollupCheckpoint(models.ResolutionHour) + `
+			UNION ALL
+			SELECT ` + rollupColumns + ` FROM rates_1m
+			WHERE bucket >= ` + rollupCheckpoint(models.ResolutionHour) + ` AND bucket < ` + rollupCheckpoint(models.ResolutionMinute) + `
+			UNION ALL
+			` + raw + ` WHERE ts >= ` + rollupCheckpoint(models.ResolutionMinute) + `
+		) AS history (` + rateColumns + `)`
+	default:
+		return `rates`
+	}
+}
+
+// GetRollupCheckpoint - method for get the ts the history is rolled up to at the resolution
+func (s *Store) GetRollupCheckpoint(ctx context.Context, resolution models.Resolution) (int64, error) {
+	const query = `SELECT rolled_to FROM rollup_checkpoints WHERE resolution = $1`
+
+	var rolledTo int64
+
+	if err := s.queryRow(ctx, query, s.Master, string(resolution)).Scan(&rolledTo); err != nil {
+		return 0, fmt.Errorf("GetRollupCheckpoint: %w", err)
+	}
+
+	return rolledTo, nil
+}
+
+// GetRollupSourceStart - method for get the earliest ts of the history the resolution is
+// rolled up from. ok is false when that history is empty.
+func (s *Store) GetRollupSourceStart(ctx context.Context, resolution models.Resolution) (start int64, ok bool, err error) {
+	query := `SELECT min(ts) FROM rates`
+	if resolution == models.ResolutionHour {
+		query = `SELECT min(bucket) FROM rates_1m`
+	}
+
+	var ts *int64
+
+	if err = s.queryRow(ctx, query, s.Master).Scan(&ts); err != nil {
+		return 0, false, fmt.Errorf("GetRollupSourceStart: %w", err)
+	}
+
+	if ts == nil {
+		return 0, false, nil
+	}
+
+	return *ts, true, nil
+}
+
+// RollUpRates - method for roll up the history from the checkpoint of the resolution to to,
+// which must be aligned to its buckets, and advance the checkpoint in the same transaction.
+// Buckets are recomputed as a whole, so re-runs over the same range are idempotent; a
+// recomputed bucket with fewer snapshots than the stored one does not replace it.
+// Returns the number of written buckets.
+func (s *Store) RollUpRates(ctx context.Context, resolution models.Resolution, to int64) (int64, error) {
+	const lockQuery = `SELECT rolled_to FROM rollup_checkpoints WHERE resolution = $1 FOR UPDATE`
+
+	const minuteQuery = `
+		INSERT INTO rates_1m AS stored (
+			market, bucket, ask_open, ask_high, ask_low, ask_close, bid_open, bid_high, bid_low, bid_close,
+			samples, last_ts, source, fetched_at
+		)
+		SELECT
+			market,
+			ts - ts % 60,
+			(array_agg(ask_price ORDER BY ts, id))[1],
+			max(ask_price),
+			min(ask_price),
+			(array_agg(ask_price ORDER BY ts DESC, id DESC))[1],
+			(array_agg(bid_price ORDER BY ts, id))[1],
+			max(bid_price),
+			min(bid_price),
+			(array_agg(bid_price ORDER BY ts DESC, id DESC))[1],
+			count(*),
+			max(ts),
+			(array_agg(source ORDER BY ts DESC, id DESC))[1],
+			(array_agg(fetched_at ORDER BY ts DESC, id DESC))[1]
+		FROM rates
+		WHERE ts >= $1 AND ts < $2
+		GROUP BY market, ts - ts % 60
+		ON CONFLICT (market, bucket) DO UPDATE SET ` + rollupUpdateSet
+
+	const hourQuery = `
+		INSERT INTO rates_1h AS stored (
+			market, bucket, ask_open, ask_high, ask_low, ask_close, bid_open, bid_high, bid_low, bid_close,
+			samples, last_ts, source, fetched_at
+		)
+		SELECT
+			market,
+			bucket - bucket % 3600,
+			(array_agg(ask_open ORDER BY bucket))[1],
+			max(ask_high),
+			min(ask_low),
+			(array_agg(ask_close ORDER BY bucket DESC))[1],
+			(array_agg(bid_open ORDER BY bucket))[1],
+			max(bid_high),
+			min(bid_low),
+			(array_agg(bid_close ORDER BY bucket DESC))[1],
+			sum(samples),
+			max(last_ts),
+			(array_agg(source ORDER BY bucket DESC))[1],
+			(array_agg(fetched_at ORDER BY bucket DESC))[1]
+		FROM rates_1m
+		WHERE bucket >= $1 AND bucket < $2
+		GROUP BY market, bucket - bucket % 3600
+		ON CONFLICT (market, bucket) DO UPDATE SET ` + rollupUpdateSet
+
+	const advanceQuery = `UPDATE rollup_checkpoints SET rolled_to = $2, updated_at = NOW() WHERE resolution = $1`
+
+	query := minuteQuery
+	if resolution == models.ResolutionHour {
+		query = hourQuery
+	}
+
+	var n int64
+
+	err := s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+		var from int64
+
+		if err := s.queryRow(ctx, lockQuery, tx, string(resolution)).Scan(&from); err != nil {
+			return err
+		}
+
+		// Another instance got there first.
+		if from >= to {
+			return nil
+		}
+
+		tag, err := s.exec(ctx, query, tx, from, to)
+		if err != nil {
+			return err
+		}
+
+		n = tag.RowsAffected()
+
+		_, err = s.exec(ctx, advanceQuery, tx, string(resolution), to)
+
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("RollUpRates: %w", err)
+	}
+
+	return n, nil
+}
+
+// rollupUpdateSet - replaces a stored aggregate with the recomputed one, unless the latter
+// is built from fewer snapshots. Snapshots only go with the retention, so such a bucket was
+// recomputed over a range that is partly purged and the stored one is kept.
+const rollupUpdateSet = `
+	ask_open = EXCLUDED.ask_open, ask_high = EXCLUDED.ask_high, ask_low = EXCLUDED.ask_low, ask_close = EXCLUDED.ask_close,
+	bid_open = EXCLUDED.bid_open, bid_high = EXCLUDED.bid_high, bid_low = EXCLUDED.bid_low, bid_close = EXCLUDED.bid_close,
+	samples = EXCLUDED.samples, last_ts = EXCLUDED.last_ts, source = EXCLUDED.source, fetched_at = EXCLUDED.fetched_at
+	WHERE EXCLUDED.samples >= stored.samples`
+
+// PurgeRollups - method for delete the aggregates of the resolution with buckets before before.
+// Returns the number of deleted buckets.
+func (s *Store) PurgeRollups(ctx context.Context, resolution models.Resolution, before int64) (int64, error) {
+	table, ok := rollupTables[resolution]
+	if !ok {
+		return 0, fmt.Errorf("PurgeRollups: unknown resolution %q", resolution)
+	}
+
+	tag, err := s.exec(ctx, `DELETE FROM `+table+` WHERE bucket < $1`, s.Master, before)
+	if err != nil {
+		return 0, fmt.Errorf("PurgeRollups: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// rewindRollups - moves the checkpoints back to the buckets containing ts, so that
+// the aggregates over rates written late are rolled up again. Where the retention has
+// purged part of the rewound range the stored aggregates are kept, see rollupUpdateSet.
+func (s *Store) rewindRollups(ctx context.Context, tx pgclient.DB, ts int64) error {
+	const query = `
+		UPDATE rollup_checkpoints
+		SET rolled_to = CASE resolution WHEN '1h' THEN $1 - $1 % 3600 ELSE $1 - $1 % 60 END,
+		    updated_at = NOW()
+		WHERE rolled_to > CASE resolution WHEN '1h' THEN $1 - $1 % 3600 ELSE $1 - $1 % 60 END`
+
+	_, err := s.exec(ctx, query, tx, ts)
+
+	return err
+}
@@ -0,0 +1,341 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/wal"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// replayTimeout - time limit of writing one batch of the queued rates
+const replayTimeout = time.Minute
+
+// rateQueue keeps the rates that could not be written while master was unreachable
+// in a write-ahead queue on disk and replays them in order once it is back. Rates
+// that are already stored are skipped on replay, so a batch written right before
+// a crash is not stored twice.
+type rateQueue struct {
+	log    *slog.Logger
+	cfg    config.RateQueue
+	wal    *wal.Queue
+	replay func(ctx context.Context, rates []*models.ExchangeRate) (int64, error)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// queuedRate - rate as it is kept in the queue
+type queuedRate struct {
+	Market    string            `json:"market"`
+	AskPrice  decimal.Decimal   `json:"ask_price"`
+	BidPrice  decimal.Decimal   `json:"bid_price"`
+	TS        int64             `json:"ts"`
+	Source    string            `json:"source"`
+	FetchedAt time.Time         `json:"fetched_at"`
+	LatencyMs int64             `json:"latency_ms"`
+	RequestID string            `json:"request_id"`
+	Book      *models.OrderBook `json:"book,omitempty"`
+}
+
+func newRateQueue(
+	log *slog.Logger,
+	cfg config.RateQueue,
+	replay func(ctx context.Context, rates []*models.ExchangeRate) (int64, error),
+) (*rateQueue, error) {
+	queue, err := wal.Open(cfg.Dir, cfg.SegmentSizeMB<<20, cfg.MaxSizeMB<<20)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	q := &rateQueue{
+		log:    log.With("component", "rate_queue"),
+		cfg:    cfg,
+		wal:    queue,
+		replay: replay,
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	if lost := queue.Lost(); lost > 0 {
+		q.log.Error("rate queue was corrupted, the rates after the corruption are lost", "bytes", lost)
+	}
+
+	if n := queue.Len(); n > 0 {
+		q.log.Info("rate queue holds rates from the previous run", "rows", n)
+	}
+
+	q.observe()
+
+	go q.run()
+
+	return q, nil
+}
+
+// write writes the rates with write while the queue is empty. The rates are
+// queued instead when master is unreachable or earlier rates are still queued,
+// so that they are stored in order.
+func (q *rateQueue) write(ctx context.Context, rates []*models.ExchangeRate, write func() error) error {
+	if q.wal.Len() == 0 {
+		err := write()
+		if err == nil || !unreachable(ctx, err) {
+			return err
+		}
+
+		q.log.WarnContext(ctx, "master is unreachable, queueing rates", "rows", len(rates), "error", err)
+	}
+
+	records := make([][]byte, 0, len(rates))
+
+	for _, rate := range rates {
+		record, err := json.Marshal(queuedRate{
+			Market:    rate.Market,
+			AskPrice:  rate.AskPrice,
+			BidPrice:  rate.BidPrice,
+			TS:        rate.TS,
+			Source:    rate.Source,
+			FetchedAt: rate.FetchedAt,
+			LatencyMs: rate.Latency.Milliseconds(),
+			RequestID: rate.RequestID,
+			Book:      rate.Book,
+		})
+		if err != nil {
+			return err
+		}
+
+		records = append(records, record)
+	}
+
+	err := q.wal.Append(records...)
+	if errors.Is(err, wal.ErrFull) {
+		rateQueueDroppedRows.Add(float64(len(rates)))
+
+		return models.ErrWriteQueueFull
+	}
+
+	if err != nil {
+		if errors.Is(err, wal.ErrRecordSize) {
+			rateQueueDroppedRows.Add(float64(len(rates)))
+		}
+
+		return err
+	}
+
+	rateQueueQueuedRows.Add(float64(len(rates)))
+	q.observe()
+
+	return nil
+}
+
+// close stops the replay, the queued rates are replayed after a restart.
+func (q *rateQueue) close() error {
+	q.cancel()
+	<-q.done
+
+	return q.wal.Close()
+}
+
+func (q *rateQueue) run() {
+	defer close(q.done)
+
+	ticker := time.NewTicker(q.cfg.ReplayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-q.ctx.Done():
+			return
+		case <-ticker.C:
+			q.drain()
+		}
+	}
+}
+
+// drain replays the queued rates batch by batch until the queue is empty or
+// master fails.
+func (q *rateQueue) drain() {
+	for q.wal.Len() > 0 && q.ctx.Err() == nil {
+		records, err := q.wal.Peek(q.cfg.ReplayBatchSize)
+		if err != nil {
+			q.log.Error("failed to read queued rates", "error", err)
+
+			return
+		}
+
+		rates := make([]*models.ExchangeRate, 0, len(records))
+
+		for _, record := range records {
+			var rate queuedRate
+
+			// Written by an incompatible version, it would block the queue forever.
+			if err = json.Unmarshal(record, &rate); err != nil {
+				q.log.Error("dropping unreadable queued rate", "error", err)
+				rateQueueDroppedRows.Inc()
+
+				continue
+			}
+
+			rates = append(rates, &models.ExchangeRate{
+				Market:    rate.Market,
+				AskPrice:  rate.AskPrice,
+				BidPrice:  rate.BidPrice,
+				TS:        rate.TS,
+				Source:    rate.Source,
+				FetchedAt: rate.FetchedAt,
+				Latency:   time.Duration(rate.LatencyMs) * time.Millisecond,
+				RequestID: rate.RequestID,
+				Book:      rate.Book,
+			})
+		}
+
+		ctx, cancel := context.WithTimeout(q.ctx, replayTimeout)
+		n, err := q.replay(ctx, rates)
+		cancel()
+
+		if err != nil {
+			q.log.Warn("failed to replay queued rates", "rows", len(rates), "queued", q.wal.Len(), "error", err)
+
+			return
+		}
+
+		if err = q.wal.Ack(len(records)); err != nil {
+			q.log.Error("failed to acknowledge replayed rates", "error", err)
+
+			return
+		}
+
+		rateQueueReplayedRows.Add(float64(n))
+		q.observe()
+
+		q.log.Info("replayed queued rates", "rows", len(rates), "stored", n, "queued", q.wal.Len())
+	}
+}
+
+// observe updates the queue gauges.
+func (q *rateQueue) observe() {
+	rateQueueLength.Set(float64(q.wal.Len()))
+	rateQueueBytes.Set(float64(q.wal.Size()))
+}
+
+// replayExchangeRates - method for save the queued exchange rates in one transaction.
+// Rates stored already, by a replay interrupted before it was acknowledged, are
+// skipped. The aggregates over the saved rates are rolled up again. Returns the
+// number of saved rates.
+func (s *Store) replayExchangeRates(ctx context.Context, rates []*models.ExchangeRate) (int64, error) {
+	const storedQuery = `
+		SELECT EXISTS (
+			SELECT 1 FROM rates
+			WHERE market = $1 AND ts = $2 AND fetched_at = $3 AND request_id = $4
+		)`
+
+	saved := make([]*models.ExchangeRate, 0, len(rates))
+
+	err := s.withRatePartitions(ctx, rates, func() error {
+		return s.Master.ExecTx(ctx, func(ctx context.Context, tx pgclient.DB) error {
+			saved = saved[:0]
+
+			// Earliest ts of the saved rates per market, the ranges are recomputed from there.
+			from := make(map[string]int64)
+
+			for _, rate := range rates {
+				var stored bool
+
+				err := s.queryRow(ctx, storedQuery, tx, rate.Market, rate.TS, rate.FetchedAt, rate.RequestID).Scan(&stored)
+				if err != nil {
+					return err
+				}
+
+				if stored {
+					continue
+				}
+
+				row, err := rateCopyRow(rate)
+				if err != nil {
+					return err
+				}
+
+				if err = s.queryRow(ctx, insertRateQuery, tx, row...).Scan(&rate.ID); err != nil {
+					return err
+				}
+
+				saved = append(saved, rate)
+
+				if ts, ok := from[rate.Market]; !ok || rate.TS < ts {
+					from[rate.Market] = rate.TS
+				}
+			}
+
+			if len(saved) == 0 {
+				return nil
+			}
+
+			// Markets are locked in a fixed order, so that concurrent batches do not deadlock.
+			for _, market := range slices.Sorted(maps.Keys(from)) {
+				if err := s.closeRateRanges(ctx, tx, market, from[market]); err != nil {
+					return err
+				}
+			}
+
+			if err := s.rewindRollups(ctx, tx, slices.Min(slices.Collect(maps.Values(from)))); err != nil {
+				return err
+			}
+
+			if err := s.insertRateEvents(ctx, tx, saved); err != nil {
+				return err
+			}
+
+			return s.notifyRates(ctx, tx, saved)
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(saved)), nil
+}
+
+// unreachable reports whether err means master could not be reached, rather than
+// that it refused the statement. A canceled request is not a reason to queue.
+func unreachable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// connection_exception, too_many_connections and the server shutting down.
+		return strings.HasPrefix(pgErr.Code, "08") ||
+			pgErr.Code == "53300" ||
+			pgErr.Code == "57P01" ||
+			pgErr.Code == "57P02" ||
+			pgErr.Code == "57P03"
+	}
+
+	var (
+		connectErr *pgconn.ConnectError
+		netErr     net.Error
+	)
+
+	return errors.As(err, &connectErr) ||
+		errors.As(err, &netErr) ||
+		pgconn.Timeout(err) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
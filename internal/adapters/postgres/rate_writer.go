@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+const (
+	dropReasonQueueFull   = "queue_full"
+	dropReasonFlushFailed = "flush_failed"
+)
+
+// rateWriter buffers rates and writes them to the database in batches using the
+// copy protocol, either when batchSize rates are collected or every flushInterval.
+// Producers wait up to enqueueTimeout for room in a full queue before the rate
+// is dropped.
+type rateWriter struct {
+	log   *slog.Logger
+	flush func(ctx context.Context, rates []*models.ExchangeRate) (int64, error)
+	cfg   config.RateWriter
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan *models.ExchangeRate
+	done   chan struct{}
+}
+
+func newRateWriter(
+	log *slog.Logger,
+	cfg config.RateWriter,
+	flush func(ctx context.Context, rates []*models.ExchangeRate) (int64, error),
+) *rateWriter {
+	w := &rateWriter{
+		log:   log.With("component", "rate_writer"),
+		flush: flush,
+		cfg:   cfg,
+		queue: make(chan *models.ExchangeRate, cfg.QueueSize),
+		done:  make(chan struct{}),
+	}
+
+	go w.run()
+
+	return w
+}
+
+// enqueue adds rate to the next batch.
+func (w *rateWriter) enqueue(ctx context.Context, rate *models.ExchangeRate) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed {
+		rateWriterDroppedRows.WithLabelValues(dropReasonQueueFull).Inc()
+
+		return models.ErrWriteQueueFull
+	}
+
+	select {
+	case w.queue <- rate:
+		return nil
+	default:
+	}
+
+	timer := time.NewTimer(w.cfg.EnqueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case w.queue <- rate:
+		return nil
+	case <-timer.C:
+		rateWriterDroppedRows.WithLabelValues(dropReasonQueueFull).Inc()
+
+		return models.ErrWriteQueueFull
+	case <-ctx.Done():
+		rateWriterDroppedRows.WithLabelValues(dropReasonQueueFull).Inc()
+
+		return ctx.Err()
+	}
+}
+
+// close stops accepting rates and waits until the buffered ones are flushed.
+func (w *rateWriter) close(ctx context.Context) error {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.queue)
+	}
+	w.mu.Unlock()
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *rateWriter) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*models.ExchangeRate, 0, w.cfg.BatchSize)
+
+	for {
+		select {
+		case rate, ok := <-w.queue:
+			if !ok {
+				w.write(batch)
+
+				return
+			}
+
+			batch = append(batch, rate)
+			if len(batch) >= w.cfg.BatchSize {
+				w.write(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				w.write(batch)
+				batch = batch[:0]
+			}
+		}
+
+		rateWriterQueueLength.Set(float64(len(w.queue) + len(batch)))
+	}
+}
+
+func (w *rateWriter) write(batch []*models.ExchangeRate) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), w.cfg.FlushTimeout)
+	defer cancel()
+
+	start := time.Now()
+
+	n, err := w.flush(ctx, batch)
+
+	rateWriterFlushDuration.Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		rateWriterDroppedRows.WithLabelValues(dropReasonFlushFailed).Add(float64(len(batch)))
+		w.log.Error("failed to flush rates", "rows", len(batch), "error", err)
+
+		return
+	}
+
+	rateWriterFlushedRows.Add(float64(n))
+}
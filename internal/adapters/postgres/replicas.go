@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	pgclient "github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres/pgx_conn"
+)
+
+// replica is a read replica connection together with its last known state.
+type replica struct {
+	addr    string
+	conn    ConnClient
+	healthy atomic.Bool
+}
+
+// replicaSet spreads the read-only queries over the healthy replicas. A replica
+// is healthy while it answers and lags behind master by at most maxLag.
+type replicaSet struct {
+	log      *slog.Logger
+	master   ConnClient
+	replicas []*replica
+	maxLag   time.Duration
+	interval time.Duration
+	next     atomic.Uint64
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+func newReplicaSet(log *slog.Logger, master ConnClient, replicas []*replica, maxLag, interval time.Duration) *replicaSet {
+	return &replicaSet{
+		log:      log.With("component", "replicas"),
+		master:   master,
+		replicas: replicas,
+		maxLag:   maxLag,
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// start checks the replicas once and then keeps checking them every interval.
+func (rs *replicaSet) start(ctx context.Context) {
+	rs.check(ctx)
+
+	go func() {
+		defer close(rs.done)
+
+		ticker := time.NewTicker(rs.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				rs.check(context.Background())
+			case <-rs.stop:
+				return
+			}
+		}
+	}()
+}
+
+// check updates the health of every replica. A replica that has replayed the WAL
+// master had written before the check has no lag, whether it still receives the
+// WAL or not; otherwise its lag is the age of its last replayed transaction. A
+// replica that lost its WAL receiver thus becomes lagging once master writes and
+// maxLag passes, rather than serving the history it has forever.
+func (rs *replicaSet) check(ctx context.Context) {
+	const masterQuery = `SELECT pg_current_wal_lsn()::TEXT`
+
+	// The replay timestamp only moves on writes, so an idle master does not
+	// make a replica that has replayed everything look lagging. A replica that
+	// has not replayed a transaction yet can not tell its lag.
+	const query = `
+		SELECT CASE
+			WHEN pg_last_wal_replay_lsn() >= $1::PG_LSN THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM NOW() - pg_last_xact_replay_timestamp())::FLOAT8, 'Infinity')
+		END`
+
+	// Without master the replicas are compared with the WAL they have received,
+	// which can not tell a disconnected receiver.
+	const receivedQuery = `
+		SELECT CASE
+			WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM NOW() - pg_last_xact_replay_timestamp())::FLOAT8, 'Infinity')
+		END`
+
+	masterCtx, cancel := context.WithTimeout(ctx, rs.interval)
+
+	var masterLSN string
+
+	masterErr := rs.master.QueryRow(masterCtx, masterQuery).Scan(&masterLSN)
+
+	cancel()
+
+	if masterErr != nil {
+		rs.log.Warn("failed to read the WAL position of master, checking replicas by the received WAL", "error", masterErr)
+	}
+
+	for _, r := range rs.replicas {
+		ctx, cancel := context.WithTimeout(ctx, rs.interval)
+
+		var (
+			lag float64
+			err error
+		)
+
+		if masterErr == nil {
+			err = r.conn.QueryRow(ctx, query, masterLSN).Scan(&lag)
+		} else {
+			err = r.conn.QueryRow(ctx, receivedQuery).Scan(&lag)
+		}
+
+		cancel()
+
+		healthy := err == nil && lag <= rs.maxLag.Seconds()
+
+		if err == nil {
+			replicaLag.WithLabelValues(r.addr).Set(lag)
+		}
+
+		if r.healthy.Swap(healthy) != healthy {
+			rs.log.Warn("replica state changed", "replica", r.addr, "healthy", healthy, "lag_seconds", lag, "error", err)
+		}
+
+		replicaHealthy.WithLabelValues(r.addr).Set(boolToFloat(healthy))
+	}
+}
+
+// pick returns the next healthy replica, nil when there is none.
+func (rs *replicaSet) pick() *replica {
+	if rs == nil {
+		return nil
+	}
+
+	n := uint64(len(rs.replicas))
+
+	for range n {
+		r := rs.replicas[rs.next.Add(1)%n]
+		if r.healthy.Load() {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// markDown takes the replica out of rotation until the next check.
+func (rs *replicaSet) markDown(r *replica, err error) {
+	if r.healthy.Swap(false) {
+		rs.log.Warn("replica failed, falling back to master", "replica", r.addr, "error", err)
+
+		replicaHealthy.WithLabelValues(r.addr).Set(0)
+	}
+}
+
+// close stops the checks and closes the replica connections.
+func (rs *replicaSet) close(ctx context.Context) {
+	if rs == nil {
+		return
+	}
+
+	rs.once.Do(func() {
+		close(rs.stop)
+		<-rs.done
+
+		for _, r := range rs.replicas {
+			r.conn.Close(ctx)
+		}
+	})
+}
+
+// readTx runs f in a read-only transaction on a healthy replica. Master serves
+// it when no replica is healthy or the replica fails to answer.
+func (s *Store) readTx(ctx context.Context, f func(ctx context.Context, tx pgclient.DB) error) error {
+	readOnly := &pgclient.TxParamsAccessMode{TxAccessMode: pgx.ReadOnly}
+
+	if r := s.replicas.pick(); r != nil {
+		err := r.conn.ExecTx(ctx, f, readOnly)
+		if !isConnectionError(ctx, err) {
+			return err
+		}
+
+		s.replicas.markDown(r, err)
+	}
+
+	return s.Master.ExecTx(ctx, f, readOnly)
+}
+
+// isConnectionError reports whether err comes from reaching the database rather
+// than from the query itself, so that another server may answer the query. Errors
+// of scanning the results and the like are returned as they are.
+func isConnectionError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	// SafeToRetry covers a connection found closed before the statement was sent.
+	return unreachable(ctx, err) || pgconn.SafeToRetry(err) || errors.Is(err, net.ErrClosed)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,87 @@
+package postgres_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/postgres"
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/storagetest"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+)
+
+// testDSNEnv - connection string of a database the test may migrate and write to,
+// the test is skipped when it is not set
+const testDSNEnv = "EXCHANGE_TEST_POSTGRES_DSN"
+
+func TestStoreConformance(t *testing.T) {
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	ctx := context.Background()
+
+	store, err := postgres.NewClient(ctx, slog.New(slog.NewTextHandler(io.Discard, nil)), testConfig(t, dsn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close(ctx)
+
+	// The suite needs its markets to have no rates, also after a failed run.
+	const cleanup = `DELETE FROM rates WHERE market IN ('conformance_a', 'conformance_b')`
+
+	if _, err = store.Master.Exec(ctx, cleanup); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if _, err := store.Master.Exec(ctx, cleanup); err != nil {
+			t.Error(err)
+		}
+	})
+
+	if err = storagetest.TestRateStorage(ctx, store); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testConfig - the defaults with master taken from dsn and the rates saved synchronously
+func testConfig(t *testing.T, dsn string) *config.Config {
+	t.Helper()
+
+	pgCfg, err := pgconn.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("%s: %v", testDSNEnv, err)
+	}
+
+	var cfg config.Config
+
+	if err = cleanenv.ReadEnv(&cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.Postgres.Host = pgCfg.Host
+	cfg.Postgres.MasterPort = int(pgCfg.Port)
+	cfg.Postgres.DbName = pgCfg.Database
+	cfg.Postgres.Login = pgCfg.User
+	cfg.Postgres.Password = pgCfg.Password
+	cfg.Postgres.Replicas = nil
+	cfg.Postgres.AutoMigrate = true
+
+	cfg.RateWriter.Enabled = false
+	cfg.RateQueue.Enabled = false
+	cfg.RateFanout.Enabled = false
+	cfg.Outbox.Enabled = false
+
+	if cfg.Postgres.Host == "" {
+		t.Fatalf("%s: host is required", testDSNEnv)
+	}
+
+	return &cfg
+}
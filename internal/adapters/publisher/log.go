@@ -0,0 +1,30 @@
+package publisher
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// LogPublisher writes the events to the log.
+type LogPublisher struct {
+	log *slog.Logger
+}
+
+func NewLogPublisher(log *slog.Logger) *LogPublisher {
+	return &LogPublisher{log: log.With("component", "publisher")}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	p.log.InfoContext(ctx, "rate event",
+		"id", event.ID,
+		"type", event.Type,
+		"market", event.Market,
+		"ts", event.Rate.TS,
+		"ask_price", event.Rate.AskPrice,
+		"bid_price", event.Rate.BidPrice,
+	)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// Package publisher contains the publishers the outbox events are delivered to.
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+const (
+	publisherLog     = "log"
+	publisherWebhook = "webhook"
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, event *models.OutboxEvent) error
+}
+
+// New returns the publisher selected by the outbox settings.
+func New(log *slog.Logger, cfg *config.Config) (Publisher, error) {
+	switch cfg.Outbox.Publisher {
+	case publisherLog:
+		return NewLogPublisher(log), nil
+	case publisherWebhook:
+		if cfg.Outbox.WebhookURL == "" {
+			return nil, fmt.Errorf("outbox webhook url is required")
+		}
+
+		return NewWebhookPublisher(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown outbox publisher %q", cfg.Outbox.Publisher)
+	}
+}
@@ -0,0 +1,56 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// WebhookPublisher posts the events as JSON. An event may be posted more than
+// once, receivers deduplicate by the X-Event-Id header.
+type WebhookPublisher struct {
+	httpClient *http.Client
+	URL        string
+}
+
+func NewWebhookPublisher(cfg *config.Config) *WebhookPublisher {
+	return &WebhookPublisher{
+		httpClient: &http.Client{
+			Timeout: cfg.Outbox.WebhookTimeout,
+		},
+		URL: cfg.Outbox.WebhookURL,
+	}
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, event *models.OutboxEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", strconv.FormatInt(event.ID, 10))
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("status: %d, body: %s", resp.StatusCode, resp.Status)
+	}
+
+	return nil
+}
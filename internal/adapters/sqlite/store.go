@@ -0,0 +1,365 @@
+// Package sqlite contains a storage that keeps the rate history in an embedded
+// SQLite file, for local development without a database server.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	// Registers the "sqlite" database/sql driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/memory"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// schema - created on open, the file needs no migrations
+const schema = `
+	CREATE TABLE IF NOT EXISTS rates(
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		market TEXT NOT NULL,
+		ask_price TEXT NOT NULL,
+		bid_price TEXT NOT NULL,
+		ts INTEGER NOT NULL,
+		source TEXT NOT NULL DEFAULT '',
+		fetched_at INTEGER NOT NULL,
+		latency_ms INTEGER NOT NULL DEFAULT 0,
+		request_id TEXT NOT NULL DEFAULT '',
+		valid_to INTEGER,
+		book TEXT
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_rates_market_ts ON rates(market, ts, id);`
+
+// rateColumns - columns read by scanExchangeRate, in scan order
+const rateColumns = `id, market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id, valid_to`
+
+// Store keeps the rate history in a SQLite file, the markets, overrides and
+// quotes are kept in memory. Every resolution is served from the raw history.
+type Store struct {
+	*memory.Registry
+
+	db *sql.DB
+}
+
+func New(ctx context.Context, logger *slog.Logger, cfg *config.Config) (*Store, error) {
+	path := cfg.Storage.SQLitePath
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite has a single writer, serializing the connections keeps the
+	// validity ranges exact without locking.
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.ExecContext(ctx, schema); err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+
+	logger.Info("sqlite storage opened", "path", path)
+
+	return &Store{
+		Registry: memory.NewRegistry(),
+		db:       db,
+	}, nil
+}
+
+// Close closes the database file.
+func (s *Store) Close(_ context.Context) {
+	_ = s.db.Close()
+}
+
+// Ping - checks the database file is still accessible
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+// SaveExchangeRate - method for save exchange rate, the validity ranges of the
+// snapshots from the one in effect before its ts onwards are recomputed
+func (s *Store) SaveExchangeRate(ctx context.Context, rate *models.ExchangeRate) error {
+	const insertQuery = `
+		INSERT INTO rates (
+			market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id, book
+		) VALUES (
+			?, ?, ?, ?, ?, ?, ?, ?, ?
+		) RETURNING id`
+
+	const rangesQuery = `
+		UPDATE rates
+		SET valid_to = (
+			SELECT n.ts FROM rates n
+			WHERE n.market = rates.market AND (n.ts, n.id) > (rates.ts, rates.id)
+			ORDER BY n.ts, n.id
+			LIMIT 1
+		)
+		WHERE market = ?1
+		  AND ts >= COALESCE((SELECT MAX(ts) FROM rates WHERE market = ?1 AND ts < ?2), ?2)`
+
+	var book sql.NullString
+
+	if rate.Book != nil {
+		payload, err := json.Marshal(rate.Book)
+		if err != nil {
+			return fmt.Errorf("SaveExchangeRate: %w", err)
+		}
+
+		book = sql.NullString{String: string(payload), Valid: true}
+	}
+
+	err := s.execTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, insertQuery,
+			rate.Market,
+			rate.AskPrice.String(),
+			rate.BidPrice.String(),
+			rate.TS,
+			rate.Source,
+			rate.FetchedAt.UnixMicro(),
+			rate.Latency.Milliseconds(),
+			rate.RequestID,
+			book,
+		).Scan(&rate.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, rangesQuery, rate.Market, rate.TS)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("SaveExchangeRate: %w", err)
+	}
+
+	return nil
+}
+
+// GetLatestExchangeRate - method for get the exchange rate snapshot of the market
+// that is still in effect
+func (s *Store) GetLatestExchangeRate(ctx context.Context, market string) (*models.ExchangeRate, error) {
+	const query = `
+		SELECT ` + rateColumns + `
+		FROM rates
+		WHERE market = ?
+		ORDER BY ts DESC, id DESC
+		LIMIT 1`
+
+	var rate models.ExchangeRate
+
+	if err := scanExchangeRate(s.db.QueryRowContext(ctx, query, market), &rate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrRateNotFound
+		}
+
+		return nil, fmt.Errorf("GetLatestExchangeRate: %w", err)
+	}
+
+	return &rate, nil
+}
+
+// GetExchangeRateAt - method for get the exchange rate snapshot of the market whose
+// validity range contains ts, taken not earlier than notBefore
+func (s *Store) GetExchangeRateAt(
+	ctx context.Context,
+	market string,
+	_ models.Resolution,
+	ts, notBefore int64,
+) (*models.ExchangeRate, error) {
+	const query = `
+		SELECT ` + rateColumns + `
+		FROM rates
+		WHERE market = ?1
+		  AND ts <= ?2
+		  AND ts >= ?3
+		  AND (valid_to IS NULL OR valid_to > ?2)
+		ORDER BY ts DESC, id DESC
+		LIMIT 1`
+
+	var rate models.ExchangeRate
+
+	if err := scanExchangeRate(s.db.QueryRowContext(ctx, query, market, ts, notBefore), &rate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrRateNotFound
+		}
+
+		return nil, fmt.Errorf("GetExchangeRateAt: %w", err)
+	}
+
+	return &rate, nil
+}
+
+// GetOrderBookAt - method for get the order book of the market snapshot whose validity
+// range contains ts, taken not earlier than notBefore
+func (s *Store) GetOrderBookAt(ctx context.Context, market string, ts, notBefore int64) (*models.OrderBook, error) {
+	const query = `
+		SELECT ts, book
+		FROM rates
+		WHERE market = ?1
+		  AND ts <= ?2
+		  AND ts >= ?3
+		  AND (valid_to IS NULL OR valid_to > ?2)
+		ORDER BY ts DESC, id DESC
+		LIMIT 1`
+
+	var (
+		book    = models.OrderBook{Market: market}
+		payload sql.NullString
+	)
+
+	if err := s.db.QueryRowContext(ctx, query, market, ts, notBefore).Scan(&book.TS, &payload); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrOrderBookNotFound
+		}
+
+		return nil, fmt.Errorf("GetOrderBookAt: %w", err)
+	}
+
+	// The snapshot was stored without its book.
+	if !payload.Valid {
+		return nil, models.ErrOrderBookNotFound
+	}
+
+	if err := json.Unmarshal([]byte(payload.String), &book); err != nil {
+		return nil, fmt.Errorf("GetOrderBookAt: %w", err)
+	}
+
+	return &book, nil
+}
+
+// ListExchangeRates - method for list stored exchange rates of the market page by page,
+// ordered by (ts, id) and continuing after filter.After when it is set
+func (s *Store) ListExchangeRates(ctx context.Context, filter models.RateFilter) ([]*models.ExchangeRate, error) {
+	const queryAsc = `
+		SELECT ` + rateColumns + `
+		FROM rates
+		WHERE market = ?
+		  AND ts >= ?
+		  AND ts < ?
+		  AND (ts, id) > (?, ?)
+		ORDER BY ts, id
+		LIMIT ?`
+
+	const queryDesc = `
+		SELECT ` + rateColumns + `
+		FROM rates
+		WHERE market = ?
+		  AND ts >= ?
+		  AND ts < ?
+		  AND (ts, id) < (?, ?)
+		ORDER BY ts DESC, id DESC
+		LIMIT ?`
+
+	to := filter.To
+	if to == 0 {
+		to = math.MaxInt64
+	}
+
+	query := queryAsc
+	after := models.RateCursor{TS: math.MinInt64, ID: math.MinInt64}
+
+	if filter.Desc {
+		query = queryDesc
+		after = models.RateCursor{TS: math.MaxInt64, ID: math.MaxInt64}
+	}
+
+	if filter.After != nil {
+		after = *filter.After
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, filter.Market, filter.From, to, after.TS, after.ID, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("ListExchangeRates: %w", err)
+	}
+	defer rows.Close()
+
+	rates := make([]*models.ExchangeRate, 0, filter.Limit)
+
+	for rows.Next() {
+		var rate models.ExchangeRate
+
+		if err = scanExchangeRate(rows, &rate); err != nil {
+			return nil, fmt.Errorf("ListExchangeRates: %w", err)
+		}
+
+		rates = append(rates, &rate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListExchangeRates: %w", err)
+	}
+
+	return rates, nil
+}
+
+// execTx - runs f in a transaction, committed when f succeeds
+func (s *Store) execTx(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = f(tx); err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// scanExchangeRate - scans a row selected with rateColumns into rate
+func scanExchangeRate(row interface{ Scan(dest ...any) error }, rate *models.ExchangeRate) error {
+	var (
+		askPrice, bidPrice   string
+		fetchedAt, latencyMs int64
+		validTo              sql.NullInt64
+	)
+
+	err := row.Scan(
+		&rate.ID,
+		&rate.Market,
+		&askPrice,
+		&bidPrice,
+		&rate.TS,
+		&rate.Source,
+		&fetchedAt,
+		&latencyMs,
+		&rate.RequestID,
+		&validTo,
+	)
+	if err != nil {
+		return err
+	}
+
+	if rate.AskPrice, err = decimal.NewFromString(askPrice); err != nil {
+		return err
+	}
+
+	if rate.BidPrice, err = decimal.NewFromString(bidPrice); err != nil {
+		return err
+	}
+
+	rate.FetchedAt = time.UnixMicro(fetchedAt)
+	rate.Latency = time.Duration(latencyMs) * time.Millisecond
+	rate.ValidTo = validTo.Int64
+
+	return nil
+}
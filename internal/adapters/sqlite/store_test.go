@@ -0,0 +1,30 @@
+package sqlite_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/sqlite"
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/storagetest"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+)
+
+func TestStoreConformance(t *testing.T) {
+	ctx := context.Background()
+
+	var cfg config.Config
+	cfg.Storage.SQLitePath = filepath.Join(t.TempDir(), "exchangerate.db")
+
+	store, err := sqlite.New(ctx, slog.New(slog.NewTextHandler(io.Discard, nil)), &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close(ctx)
+
+	if err = storagetest.TestRateStorage(ctx, store); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,331 @@
+// Package storagetest contains the conformance checks of the rate storage
+// contract, shared by all storage backends.
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// RateStorage - the contract checked by TestRateStorage
+type RateStorage interface {
+	SaveExchangeRate(ctx context.Context, rate *models.ExchangeRate) error
+	GetLatestExchangeRate(ctx context.Context, market string) (*models.ExchangeRate, error)
+	GetExchangeRateAt(ctx context.Context, market string, resolution models.Resolution, ts, notBefore int64) (*models.ExchangeRate, error)
+	ListExchangeRates(ctx context.Context, filter models.RateFilter) ([]*models.ExchangeRate, error)
+	GetOrderBookAt(ctx context.Context, market string, ts, notBefore int64) (*models.OrderBook, error)
+}
+
+// TestRateStorage checks that storage behaves the way the service expects from
+// a rate storage and returns every mismatch found, nil when there is none. The
+// storage must be empty and its rates must be saved synchronously.
+func TestRateStorage(ctx context.Context, storage RateStorage) error {
+	c := &checker{ctx: ctx, storage: storage}
+
+	c.checkEmpty()
+
+	if err := c.seed(); err != nil {
+		return errors.Join(append(c.errs, fmt.Errorf("seed: %w", err))...)
+	}
+
+	c.checkLatest()
+	c.checkValidity()
+	c.checkRateAt()
+	c.checkList()
+	c.checkOrderBook()
+
+	return errors.Join(c.errs...)
+}
+
+const (
+	market      = "conformance_a"
+	otherMarket = "conformance_b"
+)
+
+type checker struct {
+	ctx     context.Context
+	storage RateStorage
+	errs    []error
+	// saved are the rates of market in the save order.
+	saved []*models.ExchangeRate
+}
+
+func (c *checker) errorf(format string, args ...any) {
+	c.errs = append(c.errs, fmt.Errorf(format, args...))
+}
+
+func (c *checker) checkEmpty() {
+	if _, err := c.storage.GetLatestExchangeRate(c.ctx, market); !errors.Is(err, models.ErrRateNotFound) {
+		c.errorf("latest rate of an empty storage: got error %v, want %v", err, models.ErrRateNotFound)
+	}
+
+	if _, err := c.storage.GetExchangeRateAt(c.ctx, market, models.ResolutionRaw, 100, 0); !errors.Is(err, models.ErrRateNotFound) {
+		c.errorf("rate at of an empty storage: got error %v, want %v", err, models.ErrRateNotFound)
+	}
+
+	rates, err := c.storage.ListExchangeRates(c.ctx, models.RateFilter{Market: market, Limit: 10})
+	if err != nil || len(rates) != 0 {
+		c.errorf("list of an empty storage: got %d rates and error %v, want none", len(rates), err)
+	}
+}
+
+// seed saves the rates of market with ts 100, 200, 200, 300, 50 in that order
+// and a rate of otherMarket. The rate at 300 is saved with its order book.
+func (c *checker) seed() error {
+	fetchedAt := time.Unix(1700000000, 123456000)
+
+	for i, ts := range []int64{100, 200, 200, 300, 50} {
+		rate := &models.ExchangeRate{
+			Market:    market,
+			AskPrice:  decimal.RequireFromString(fmt.Sprintf("%d.123456789012345678", ts+1)),
+			BidPrice:  decimal.RequireFromString(fmt.Sprintf("%d.5", ts)),
+			TS:        ts,
+			Source:    "conformance",
+			FetchedAt: fetchedAt.Add(time.Duration(i) * time.Second),
+			Latency:   time.Duration(i+1) * time.Millisecond,
+			RequestID: fmt.Sprintf("request-%d", i),
+		}
+
+		if ts == 300 {
+			rate.Book = &models.OrderBook{
+				Asks: []models.OrderBookLevel{orderBookLevel("301.5", "2", "603"), orderBookLevel("302", "1.25", "377.5")},
+				Bids: []models.OrderBookLevel{orderBookLevel("299", "3", "897")},
+			}
+		}
+
+		if err := c.storage.SaveExchangeRate(c.ctx, rate); err != nil {
+			return err
+		}
+
+		if rate.ID == 0 {
+			return fmt.Errorf("rate with ts %d saved without an id", ts)
+		}
+
+		if len(c.saved) > 0 && rate.ID <= c.saved[len(c.saved)-1].ID {
+			return fmt.Errorf("rate with ts %d saved with id %d, not greater than the previous one", ts, rate.ID)
+		}
+
+		c.saved = append(c.saved, rate)
+	}
+
+	return c.storage.SaveExchangeRate(c.ctx, &models.ExchangeRate{
+		Market:    otherMarket,
+		AskPrice:  decimal.NewFromInt(1000),
+		BidPrice:  decimal.NewFromInt(999),
+		TS:        250,
+		FetchedAt: fetchedAt,
+	})
+}
+
+// byTS - the saved rates ordered by (ts, id)
+func (c *checker) byTS() []*models.ExchangeRate {
+	return []*models.ExchangeRate{c.saved[4], c.saved[0], c.saved[1], c.saved[2], c.saved[3]}
+}
+
+func (c *checker) checkLatest() {
+	rate, err := c.storage.GetLatestExchangeRate(c.ctx, market)
+	if err != nil {
+		c.errorf("latest rate: %v", err)
+
+		return
+	}
+
+	c.compare("latest rate", rate, c.saved[3], 0)
+}
+
+func (c *checker) checkValidity() {
+	rates, err := c.storage.ListExchangeRates(c.ctx, models.RateFilter{Market: market, Limit: 10})
+	if err != nil {
+		c.errorf("list: %v", err)
+
+		return
+	}
+
+	want := c.byTS()
+	validTo := []int64{100, 200, 200, 300, 0}
+
+	if len(rates) != len(want) {
+		c.errorf("list: got %d rates, want %d", len(rates), len(want))
+
+		return
+	}
+
+	for i := range want {
+		c.compare(fmt.Sprintf("list rate %d", i), rates[i], want[i], validTo[i])
+	}
+}
+
+func (c *checker) checkRateAt() {
+	cases := []struct {
+		ts, notBefore int64
+		want          *models.ExchangeRate
+	}{
+		{ts: 49, want: nil},
+		{ts: 50, want: c.saved[4]},
+		{ts: 199, want: c.saved[0]},
+		// Of the rates with the same ts the last saved one is in effect.
+		{ts: 200, want: c.saved[2]},
+		{ts: 250, want: c.saved[2]},
+		{ts: 250, notBefore: 201, want: nil},
+		{ts: 1000, want: c.saved[3]},
+	}
+
+	for _, tc := range cases {
+		name := fmt.Sprintf("rate at %d not before %d", tc.ts, tc.notBefore)
+
+		rate, err := c.storage.GetExchangeRateAt(c.ctx, market, models.ResolutionRaw, tc.ts, tc.notBefore)
+
+		switch {
+		case tc.want == nil && !errors.Is(err, models.ErrRateNotFound):
+			c.errorf("%s: got error %v, want %v", name, err, models.ErrRateNotFound)
+		case tc.want != nil && err != nil:
+			c.errorf("%s: %v", name, err)
+		case tc.want != nil:
+			if rate.ID != tc.want.ID {
+				c.errorf("%s: got rate %d, want %d", name, rate.ID, tc.want.ID)
+			}
+		}
+	}
+}
+
+func (c *checker) checkList() {
+	all := c.byTS()
+
+	cases := []struct {
+		name   string
+		filter models.RateFilter
+		want   []*models.ExchangeRate
+	}{
+		{
+			name:   "range",
+			filter: models.RateFilter{From: 100, To: 300},
+			want:   all[1:4],
+		},
+		{
+			name:   "desc",
+			filter: models.RateFilter{Desc: true},
+			want:   []*models.ExchangeRate{all[4], all[3], all[2], all[1], all[0]},
+		},
+		{
+			name:   "limit",
+			filter: models.RateFilter{Limit: 2},
+			want:   all[:2],
+		},
+		{
+			name:   "after",
+			filter: models.RateFilter{After: &models.RateCursor{TS: all[2].TS, ID: all[2].ID}},
+			want:   all[3:],
+		},
+		{
+			name:   "desc after",
+			filter: models.RateFilter{Desc: true, After: &models.RateCursor{TS: all[3].TS, ID: all[3].ID}},
+			want:   []*models.ExchangeRate{all[2], all[1], all[0]},
+		},
+	}
+
+	for _, tc := range cases {
+		tc.filter.Market = market
+		if tc.filter.Limit == 0 {
+			tc.filter.Limit = 10
+		}
+
+		rates, err := c.storage.ListExchangeRates(c.ctx, tc.filter)
+		if err != nil {
+			c.errorf("list %s: %v", tc.name, err)
+
+			continue
+		}
+
+		got := make([]int64, 0, len(rates))
+		for _, rate := range rates {
+			got = append(got, rate.ID)
+		}
+
+		want := make([]int64, 0, len(tc.want))
+		for _, rate := range tc.want {
+			want = append(want, rate.ID)
+		}
+
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			c.errorf("list %s: got ids %v, want %v", tc.name, got, want)
+		}
+	}
+
+	rates, err := c.storage.ListExchangeRates(c.ctx, models.RateFilter{Market: otherMarket, Limit: 10})
+	if err != nil || len(rates) != 1 {
+		c.errorf("list of another market: got %d rates and error %v, want 1", len(rates), err)
+	}
+}
+
+func (c *checker) checkOrderBook() {
+	book, err := c.storage.GetOrderBookAt(c.ctx, market, 350, 0)
+	if err != nil {
+		c.errorf("order book at 350: %v", err)
+	} else {
+		want := c.saved[3].Book
+
+		if book.Market != market || book.TS != 300 {
+			c.errorf("order book at 350: got %s at %d, want %s at 300", book.Market, book.TS, market)
+		}
+
+		if !equalLevels(book.Asks, want.Asks) || !equalLevels(book.Bids, want.Bids) {
+			c.errorf("order book at 350: got %+v, want %+v", book, want)
+		}
+	}
+
+	if _, err = c.storage.GetOrderBookAt(c.ctx, market, 250, 0); !errors.Is(err, models.ErrOrderBookNotFound) {
+		c.errorf("order book of a rate saved without it: got error %v, want %v", err, models.ErrOrderBookNotFound)
+	}
+
+	if _, err = c.storage.GetOrderBookAt(c.ctx, market, 350, 301); !errors.Is(err, models.ErrOrderBookNotFound) {
+		c.errorf("stale order book: got error %v, want %v", err, models.ErrOrderBookNotFound)
+	}
+}
+
+// compare reports the fields of got that differ from the saved rate want.
+func (c *checker) compare(name string, got, want *models.ExchangeRate, validTo int64) {
+	switch {
+	case got.ID != want.ID:
+		c.errorf("%s: got id %d, want %d", name, got.ID, want.ID)
+	case got.Market != want.Market || got.TS != want.TS:
+		c.errorf("%s: got %s at %d, want %s at %d", name, got.Market, got.TS, want.Market, want.TS)
+	case !got.AskPrice.Equal(want.AskPrice) || !got.BidPrice.Equal(want.BidPrice):
+		c.errorf("%s: got prices %s/%s, want %s/%s", name, got.AskPrice, got.BidPrice, want.AskPrice, want.BidPrice)
+	case got.Source != want.Source || got.RequestID != want.RequestID:
+		c.errorf("%s: got source %q and request id %q, want %q and %q", name, got.Source, got.RequestID, want.Source, want.RequestID)
+	case !got.FetchedAt.Equal(want.FetchedAt) || got.Latency != want.Latency:
+		c.errorf("%s: got fetched at %s in %s, want %s in %s", name, got.FetchedAt, got.Latency, want.FetchedAt, want.Latency)
+	case got.ValidTo != validTo:
+		c.errorf("%s: got valid to %d, want %d", name, got.ValidTo, validTo)
+	case got.Book != nil:
+		c.errorf("%s: got the order book with the rate", name)
+	}
+}
+
+func orderBookLevel(price, volume, amount string) models.OrderBookLevel {
+	return models.OrderBookLevel{
+		Price:  decimal.RequireFromString(price),
+		Volume: decimal.RequireFromString(volume),
+		Amount: decimal.RequireFromString(amount),
+	}
+}
+
+func equalLevels(got, want []models.OrderBookLevel) bool {
+	if len(got) != len(want) {
+		return false
+	}
+
+	for i := range want {
+		if !got[i].Price.Equal(want[i].Price) || !got[i].Volume.Equal(want[i].Volume) || !got[i].Amount.Equal(want[i].Amount) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,588 @@
+// Package wal contains a durable FIFO queue of records kept in append-only
+// segment files on disk.
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	segmentExt = ".seg"
+	ackFile    = "ack"
+	// headerSize - length and checksum of the record payload
+	headerSize = 8
+)
+
+var (
+	// ErrFull is returned by Append when the records do not fit into the queue.
+	ErrFull = errors.New("queue is full")
+	// ErrRecordSize is returned by Append for an empty record or one larger than a segment.
+	ErrRecordSize = errors.New("record is empty or does not fit into a segment")
+)
+
+// openSegment opens a segment file for reading, replaced in the tests to fail.
+var openSegment = func(path string) (io.ReadSeekCloser, error) {
+	return os.Open(path)
+}
+
+// segment - file holding the records base, base+1, ..., base+count-1
+type segment struct {
+	base  int64
+	count int64
+	size  int64
+}
+
+// Queue is a FIFO queue of records. A record is on disk once Append returns and
+// stays there until it is acknowledged, so the records survive a restart. Every
+// record is written as its length, CRC32 and payload; a record torn by a crash is
+// cut off when the queue is opened.
+type Queue struct {
+	dir         string
+	segmentSize int64
+	maxSize     int64
+
+	mu       sync.Mutex
+	segments []*segment
+	active   *os.File
+	// head is the number of the oldest record not acknowledged yet, headOff is
+	// its offset in the first segment.
+	head    int64
+	headOff int64
+	size    int64
+	// lost is the number of bytes dropped with a corrupted segment when opened.
+	lost int64
+}
+
+// Open opens the queue in dir, creating it when it does not exist. A segment is
+// rotated once it grows past segmentSize, Append fails once the segments would
+// take more than maxSize bytes. A record must fit into a segment.
+//
+// A segment corrupted before its end is cut off at the corrupted record; when it
+// is not the last one, the later segments are removed as well, as their records
+// can not follow the lost ones in order. Lost reports the bytes dropped.
+func Open(dir string, segmentSize, maxSize int64) (*Queue, error) {
+	// The active segment is only removed after it is rotated, the queue must fit
+	// two of them to make room for new records.
+	if segmentSize <= headerSize || maxSize < 2*segmentSize {
+		return nil, fmt.Errorf("max size %d must be at least twice the segment size %d", maxSize, segmentSize)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	q := &Queue{
+		dir:         dir,
+		segmentSize: segmentSize,
+		maxSize:     maxSize,
+	}
+
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// Append adds the records to the tail of the queue and syncs them to disk.
+// Either all of them are added or, on ErrFull or ErrRecordSize, none.
+func (q *Queue) Append(records ...[]byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var size int64
+	for _, record := range records {
+		if !q.validLength(int64(len(record))) {
+			return ErrRecordSize
+		}
+
+		size += headerSize + int64(len(record))
+	}
+
+	if q.size+size > q.maxSize {
+		return ErrFull
+	}
+
+	if last := q.segments[len(q.segments)-1]; last.size >= q.segmentSize && last.count > 0 {
+		if err := q.rotate(); err != nil {
+			return err
+		}
+	}
+
+	last := q.segments[len(q.segments)-1]
+
+	if err := q.write(records); err != nil {
+		// Later records must not follow a torn one.
+		_ = q.active.Truncate(last.size)
+
+		return err
+	}
+
+	last.count += int64(len(records))
+	last.size += size
+	q.size += size
+
+	return nil
+}
+
+// write writes the records to the active segment and syncs it.
+func (q *Queue) write(records [][]byte) error {
+	w := bufio.NewWriter(q.active)
+
+	for _, record := range records {
+		var header [headerSize]byte
+
+		binary.LittleEndian.PutUint32(header[:4], uint32(len(record)))
+		binary.LittleEndian.PutUint32(header[4:], crc32.ChecksumIEEE(record))
+
+		if _, err := w.Write(header[:]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return q.active.Sync()
+}
+
+// Peek returns up to limit oldest records, in the order they were appended.
+func (q *Queue) Peek(limit int) ([][]byte, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	records := make([][]byte, 0, min(int64(limit), q.length()))
+
+	// The head is always in the first segment.
+	off := q.headOff
+
+	for i, seg := range q.segments {
+		if len(records) == limit {
+			break
+		}
+
+		if i > 0 {
+			off = 0
+		}
+
+		err := q.readSegment(seg, off, limit-len(records), func(record []byte, _ int64) {
+			records = append(records, record)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// Ack drops the n oldest records, the segments left without records are removed.
+func (q *Queue) Ack(n int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n = int(min(int64(n), q.length()))
+	head, headOff := q.head, q.headOff
+
+	for i := 0; n > 0; i++ {
+		seg := q.segments[i]
+
+		if i > 0 {
+			headOff = 0
+		}
+
+		take := int(min(int64(n), seg.base+seg.count-head))
+		if take == 0 {
+			continue
+		}
+
+		err := q.readSegment(seg, headOff, take, func(_ []byte, next int64) {
+			headOff = next
+		})
+		if err != nil {
+			return err
+		}
+
+		head += int64(take)
+		n -= take
+	}
+
+	if err := q.saveAck(head); err != nil {
+		return err
+	}
+
+	q.head, q.headOff = head, headOff
+
+	// The last segment is kept to append to, even when every record is acknowledged.
+	for len(q.segments) > 1 && q.head >= q.segments[0].base+q.segments[0].count {
+		if err := os.Remove(q.segmentPath(q.segments[0].base)); err != nil {
+			return err
+		}
+
+		q.size -= q.segments[0].size
+		q.segments = q.segments[1:]
+	}
+
+	if q.head == q.segments[0].base {
+		q.headOff = 0
+	}
+
+	return nil
+}
+
+// Len returns the number of records not acknowledged yet.
+func (q *Queue) Len() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.length()
+}
+
+// Size returns the number of bytes taken by the segments.
+func (q *Queue) Size() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.size
+}
+
+// Lost returns the number of bytes dropped with a corrupted segment when the queue
+// was opened.
+func (q *Queue) Lost() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.lost
+}
+
+// Close closes the active segment, the records stay on disk.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.active.Close()
+}
+
+// validLength reports whether a record of the length may be kept in a segment.
+func (q *Queue) validLength(length int64) bool {
+	return length > 0 && headerSize+length <= q.segmentSize
+}
+
+func (q *Queue) length() int64 {
+	last := q.segments[len(q.segments)-1]
+
+	return last.base + last.count - q.head
+}
+
+// load reads the acknowledged position and the segments, removes the fully
+// acknowledged ones and cuts a torn record off the last one. A segment corrupted
+// before the last one is cut off the same way and becomes the last one.
+func (q *Queue) load() error {
+	head, err := q.loadAck()
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return err
+	}
+
+	var bases []int64
+
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), segmentExt)
+		if !ok {
+			continue
+		}
+
+		base, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			return fmt.Errorf("segment %s: %w", entry.Name(), err)
+		}
+
+		bases = append(bases, base)
+	}
+
+	slices.Sort(bases)
+
+	for i := 0; i < len(bases); i++ {
+		base := bases[i]
+		seg := &segment{base: base}
+
+		end, err := q.scanSegment(seg)
+		if err != nil {
+			return err
+		}
+
+		if end < seg.size {
+			if i != len(bases)-1 {
+				// The numbers of the later records are off by the lost ones.
+				lost, err := q.removeSegments(bases[i+1:])
+				if err != nil {
+					return err
+				}
+
+				q.lost += lost + seg.size - end
+				bases = bases[:i+1]
+			}
+
+			if err = os.Truncate(q.segmentPath(base), end); err != nil {
+				return err
+			}
+
+			seg.size = end
+		}
+
+		// Acknowledged before the crash, but not removed yet.
+		if base+seg.count <= head && i != len(bases)-1 {
+			if err = os.Remove(q.segmentPath(base)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		q.segments = append(q.segments, seg)
+		q.size += seg.size
+	}
+
+	if len(q.segments) == 0 {
+		q.segments = []*segment{{base: head}}
+	}
+
+	first := q.segments[0]
+
+	switch {
+	case head < first.base:
+		head = first.base
+	case head > first.base+first.count:
+		head = first.base + first.count
+	}
+
+	q.head = head
+
+	if head > first.base {
+		err = q.readSegment(first, 0, int(head-first.base), func(_ []byte, next int64) {
+			q.headOff = next
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	last := q.segments[len(q.segments)-1]
+
+	q.active, err = os.OpenFile(q.segmentPath(last.base), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	return syncDir(q.dir)
+}
+
+// rotate starts a new segment after the last one.
+func (q *Queue) rotate() error {
+	last := q.segments[len(q.segments)-1]
+	base := last.base + last.count
+
+	active, err := os.OpenFile(q.segmentPath(base), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if err = syncDir(q.dir); err != nil {
+		_ = active.Close()
+
+		return err
+	}
+
+	if err = q.active.Close(); err != nil {
+		_ = active.Close()
+
+		return err
+	}
+
+	q.active = active
+	q.segments = append(q.segments, &segment{base: base})
+
+	return nil
+}
+
+// removeSegments removes the segments and returns the number of bytes they took.
+func (q *Queue) removeSegments(bases []int64) (int64, error) {
+	var size int64
+
+	for _, base := range bases {
+		info, err := os.Stat(q.segmentPath(base))
+		if err != nil {
+			return size, err
+		}
+
+		if err = os.Remove(q.segmentPath(base)); err != nil {
+			return size, err
+		}
+
+		size += info.Size()
+	}
+
+	return size, nil
+}
+
+// scanSegment counts the valid records of the segment and returns the offset
+// after the last of them.
+func (q *Queue) scanSegment(seg *segment) (int64, error) {
+	info, err := os.Stat(q.segmentPath(seg.base))
+	if err != nil {
+		return 0, err
+	}
+
+	seg.size = info.Size()
+
+	var end int64
+
+	err = q.readSegment(seg, 0, -1, func(_ []byte, next int64) {
+		seg.count++
+		end = next
+	})
+
+	return end, err
+}
+
+// readSegment calls f with up to limit records of the segment starting at off and
+// the offset following each of them, a negative limit reads to the end. Reading
+// stops at a torn or corrupted record, including one with a length that could
+// not have been appended. Any other failure to read is returned: the records past
+// it are not known to be lost and must not be cut off.
+func (q *Queue) readSegment(seg *segment, off int64, limit int, f func(record []byte, next int64)) error {
+	file, err := openSegment(q.segmentPath(seg.base))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err = file.Seek(off, io.SeekStart); err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(file)
+
+	for n := 0; limit < 0 || n < limit; n++ {
+		var header [headerSize]byte
+
+		if _, err = io.ReadFull(r, header[:]); err != nil {
+			return endOfRecords(err)
+		}
+
+		length := int64(binary.LittleEndian.Uint32(header[:4]))
+		if !q.validLength(length) {
+			return nil
+		}
+
+		record := make([]byte, length)
+
+		if _, err = io.ReadFull(r, record); err != nil {
+			return endOfRecords(err)
+		}
+
+		if crc32.ChecksumIEEE(record) != binary.LittleEndian.Uint32(header[4:]) {
+			return nil
+		}
+
+		off += headerSize + int64(len(record))
+		f(record, off)
+	}
+
+	return nil
+}
+
+// endOfRecords returns nil when err means the segment ends, torn or not, and err
+// otherwise.
+func endOfRecords(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil
+	}
+
+	return err
+}
+
+func (q *Queue) loadAck() (int64, error) {
+	data, err := os.ReadFile(filepath.Join(q.dir, ackFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	head, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("ack: %w", err)
+	}
+
+	return head, nil
+}
+
+// saveAck replaces the acknowledged position atomically.
+func (q *Queue) saveAck(head int64) error {
+	path := filepath.Join(q.dir, ackFile)
+
+	tmp, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err = tmp.WriteString(strconv.FormatInt(head, 10)); err != nil {
+		_ = tmp.Close()
+
+		return err
+	}
+
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+
+		return err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	return syncDir(q.dir)
+}
+
+func (q *Queue) segmentPath(base int64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", base, segmentExt))
+}
+
+// syncDir makes the created, renamed and removed files of dir durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
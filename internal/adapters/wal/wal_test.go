@@ -0,0 +1,402 @@
+package wal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+const (
+	testSegmentSize = 64
+	testMaxSize     = 1024
+)
+
+func testRecords(from, to int) [][]byte {
+	records := make([][]byte, 0, to-from)
+
+	for i := from; i < to; i++ {
+		records = append(records, []byte(fmt.Sprintf("record-%02d", i)))
+	}
+
+	return records
+}
+
+func openQueue(t *testing.T, dir string) *Queue {
+	t.Helper()
+
+	q, err := Open(dir, testSegmentSize, testMaxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = q.Close() })
+
+	return q
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var files []string
+
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), segmentExt) {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+
+	slices.Sort(files)
+
+	return files
+}
+
+func expectPeek(t *testing.T, q *Queue, limit int, want [][]byte) {
+	t.Helper()
+
+	got, err := q.Peek(limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Fatalf("peek %d: got %q, want %q", limit, got, want)
+	}
+}
+
+func TestQueueRotation(t *testing.T) {
+	dir := t.TempDir()
+	q := openQueue(t, dir)
+
+	// A record takes 17 bytes, a segment is rotated after four of them.
+	for _, record := range testRecords(0, 10) {
+		if err := q.Append(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := len(segmentFiles(t, dir)); n != 3 {
+		t.Fatalf("got %d segments, want 3", n)
+	}
+
+	expectPeek(t, q, 100, testRecords(0, 10))
+	expectPeek(t, q, 3, testRecords(0, 3))
+
+	// Across the boundary of the first segment.
+	if err := q.Ack(3); err != nil {
+		t.Fatal(err)
+	}
+
+	expectPeek(t, q, 2, testRecords(3, 5))
+
+	if err := q.Ack(2); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(segmentFiles(t, dir)); n != 2 {
+		t.Fatalf("got %d segments after the first one is acknowledged, want 2", n)
+	}
+
+	expectPeek(t, q, 100, testRecords(5, 10))
+
+	if err := q.Ack(100); err != nil {
+		t.Fatal(err)
+	}
+
+	if q.Len() != 0 {
+		t.Fatalf("got %d records after all are acknowledged, want 0", q.Len())
+	}
+
+	if n := len(segmentFiles(t, dir)); n != 1 {
+		t.Fatalf("got %d segments after all records are acknowledged, want the active one", n)
+	}
+
+	if err := q.Append(testRecords(10, 11)...); err != nil {
+		t.Fatal(err)
+	}
+
+	expectPeek(t, q, 100, testRecords(10, 11))
+}
+
+func TestQueueReopenAfterPartialAck(t *testing.T) {
+	dir := t.TempDir()
+
+	q, err := Open(dir, testSegmentSize, testMaxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = q.Append(testRecords(0, 6)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = q.Ack(2); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	q = openQueue(t, dir)
+
+	if q.Len() != 4 {
+		t.Fatalf("got %d records after reopening, want 4", q.Len())
+	}
+
+	expectPeek(t, q, 100, testRecords(2, 6))
+
+	if err = q.Append(testRecords(6, 8)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = q.Ack(1); err != nil {
+		t.Fatal(err)
+	}
+
+	expectPeek(t, q, 100, testRecords(3, 8))
+}
+
+func TestQueueTornTail(t *testing.T) {
+	dir := t.TempDir()
+
+	q, err := Open(dir, testSegmentSize, testMaxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = q.Append(testRecords(0, 3)...); err != nil {
+		t.Fatal(err)
+	}
+
+	size := q.Size()
+
+	if err = q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A record cut off by a crash after its header.
+	files := segmentFiles(t, dir)
+
+	f, err := os.OpenFile(files[len(files)-1], os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = f.Write([]byte{9, 0, 0, 0, 1, 2, 3, 4, 'r', 'e'}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	q = openQueue(t, dir)
+
+	if q.Size() != size || q.Lost() != 0 {
+		t.Fatalf("got size %d and %d bytes lost after reopening, want %d and none", q.Size(), q.Lost(), size)
+	}
+
+	expectPeek(t, q, 100, testRecords(0, 3))
+
+	if err = q.Append(testRecords(3, 4)...); err != nil {
+		t.Fatal(err)
+	}
+
+	expectPeek(t, q, 100, testRecords(0, 4))
+}
+
+func TestQueueCorruptedSegment(t *testing.T) {
+	dir := t.TempDir()
+
+	q, err := Open(dir, testSegmentSize, testMaxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, record := range testRecords(0, 10) {
+		if err = q.Append(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Flip a byte of the payload of the second record of the first segment.
+	files := segmentFiles(t, dir)
+
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data[headerSize+len("record-00")+headerSize] ^= 0xff
+
+	if err = os.WriteFile(files[0], data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	q = openQueue(t, dir)
+
+	if q.Lost() == 0 {
+		t.Fatal("got no bytes lost after a corrupted segment")
+	}
+
+	if n := len(segmentFiles(t, dir)); n != 1 {
+		t.Fatalf("got %d segments after a corrupted first one, want 1", n)
+	}
+
+	expectPeek(t, q, 100, testRecords(0, 1))
+
+	if err = q.Append(testRecords(10, 11)...); err != nil {
+		t.Fatal(err)
+	}
+
+	expectPeek(t, q, 100, [][]byte{[]byte("record-00"), []byte("record-10")})
+}
+
+func TestQueueAppendAtomicity(t *testing.T) {
+	q := openQueue(t, t.TempDir())
+
+	// 60 records of 17 bytes take 1020 of the 1024 bytes.
+	if err := q.Append(testRecords(0, 60)...); err != nil {
+		t.Fatal(err)
+	}
+
+	length, size := q.Len(), q.Size()
+
+	tests := []struct {
+		name    string
+		records [][]byte
+		err     error
+	}{
+		{name: "full", records: testRecords(60, 62), err: ErrFull},
+		{name: "empty record", records: [][]byte{[]byte("x"), {}}, err: ErrRecordSize},
+		{name: "record larger than a segment", records: [][]byte{make([]byte, testSegmentSize)}, err: ErrRecordSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := q.Append(tt.records...); !errors.Is(err, tt.err) {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+
+			if q.Len() != length || q.Size() != size {
+				t.Fatalf("got %d records of %d bytes, want %d of %d", q.Len(), q.Size(), length, size)
+			}
+		})
+	}
+
+	expectPeek(t, q, 100, testRecords(0, 60))
+}
+
+// failingSegment - segment file on a failing disk
+type failingSegment struct{}
+
+func (failingSegment) Read([]byte) (int, error)       { return 0, syscall.EIO }
+func (failingSegment) Seek(int64, int) (int64, error) { return 0, nil }
+func (failingSegment) Close() error                   { return nil }
+
+// failSegment makes the segment at path fail to read until the test ends.
+func failSegment(t *testing.T, path string) {
+	t.Helper()
+
+	open := openSegment
+
+	openSegment = func(name string) (io.ReadSeekCloser, error) {
+		if name == path {
+			return failingSegment{}, nil
+		}
+
+		return open(name)
+	}
+
+	t.Cleanup(func() { openSegment = open })
+}
+
+func segmentSizes(t *testing.T, dir string) map[string]int64 {
+	t.Helper()
+
+	sizes := make(map[string]int64)
+
+	for _, file := range segmentFiles(t, dir) {
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sizes[file] = info.Size()
+	}
+
+	return sizes
+}
+
+func TestQueueReadError(t *testing.T) {
+	dir := t.TempDir()
+
+	q, err := Open(dir, testSegmentSize, testMaxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, record := range testRecords(0, 10) {
+		if err = q.Append(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	sizes := segmentSizes(t, dir)
+
+	t.Run("open", func(t *testing.T) {
+		failSegment(t, segmentFiles(t, dir)[1])
+
+		if _, err := Open(dir, testSegmentSize, testMaxSize); !errors.Is(err, syscall.EIO) {
+			t.Fatalf("got error %v, want %v", err, syscall.EIO)
+		}
+
+		if got := segmentSizes(t, dir); !maps.Equal(got, sizes) {
+			t.Fatalf("got segments %v after a failed read, want %v", got, sizes)
+		}
+	})
+
+	t.Run("peek", func(t *testing.T) {
+		q := openQueue(t, dir)
+
+		failSegment(t, segmentFiles(t, dir)[0])
+
+		if _, err := q.Peek(100); !errors.Is(err, syscall.EIO) {
+			t.Fatalf("got error %v, want %v", err, syscall.EIO)
+		}
+
+		if err := q.Ack(1); !errors.Is(err, syscall.EIO) {
+			t.Fatalf("got ack error %v, want %v", err, syscall.EIO)
+		}
+
+		if q.Len() != 10 {
+			t.Fatalf("got %d records after a failed read, want 10", q.Len())
+		}
+	})
+
+	if got := segmentSizes(t, dir); !maps.Equal(got, sizes) {
+		t.Fatalf("got segments %v after the failed reads, want %v", got, sizes)
+	}
+
+	expectPeek(t, openQueue(t, dir), 100, testRecords(0, 10))
+}
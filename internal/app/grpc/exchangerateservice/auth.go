@@ -0,0 +1,88 @@
+package exchangerateservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// adminMethods - RPCs that manage the service, allowed to the authenticated admins only.
+// The actor recorded in the audit of their changes is the one of the token.
+var adminMethods = map[string]struct{}{
+	"/exchangerateservice.ExchangeRateService/UpsertMarket":      {},
+	"/exchangerateservice.ExchangeRateService/SetMarketEnabled":  {},
+	"/exchangerateservice.ExchangeRateService/SetRateOverride":   {},
+	"/exchangerateservice.ExchangeRateService/ListRateOverrides": {},
+	"/exchangerateservice.ExchangeRateService/ClearRateOverride": {},
+}
+
+// adminToken - SHA-256 of the bearer token of an admin
+type adminToken struct {
+	actor string
+	hash  []byte
+}
+
+// adminAuth authenticates the admins by their bearer tokens. Only the hashes of
+// the tokens are configured, the actor of the matching token is the identity of
+// the caller.
+type adminAuth struct {
+	tokens []adminToken
+}
+
+// newAdminAuth parses the "<actor>:<hex SHA-256 of the token>" entries.
+func newAdminAuth(entries []string) (*adminAuth, error) {
+	auth := &adminAuth{tokens: make([]adminToken, 0, len(entries))}
+
+	for _, entry := range entries {
+		actor, digest, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || actor == "" {
+			return nil, fmt.Errorf("admin token %q is not <actor>:<sha256>", entry)
+		}
+
+		hash, err := hex.DecodeString(digest)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("admin token of %s is not a hex SHA-256", actor)
+		}
+
+		auth.tokens = append(auth.tokens, adminToken{actor: actor, hash: hash})
+	}
+
+	return auth, nil
+}
+
+// authenticate returns the actor of the bearer token of the incoming metadata.
+func (a *adminAuth) authenticate(ctx context.Context) (string, error) {
+	var token string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			token, _ = strings.CutPrefix(values[0], bearerPrefix)
+		}
+	}
+
+	if token == "" {
+		return "", status.Errorf(codes.Unauthenticated, "%s metadata with a bearer token is required", authorizationHeader)
+	}
+
+	hash := sha256.Sum256([]byte(token))
+
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare(hash[:], t.hash) == 1 {
+			return t.actor, nil
+		}
+	}
+
+	return "", status.Error(codes.Unauthenticated, "invalid admin token")
+}
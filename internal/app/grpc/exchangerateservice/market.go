@@ -0,0 +1,64 @@
+package exchangerateservice
+
+import (
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+var roundingModesToPb = map[models.RoundingMode]pb.RoundingMode{
+	models.RoundingHalfEven: pb.RoundingMode_ROUNDING_MODE_HALF_EVEN,
+	models.RoundingFloor:    pb.RoundingMode_ROUNDING_MODE_FLOOR,
+	models.RoundingCeil:     pb.RoundingMode_ROUNDING_MODE_CEIL,
+}
+
+var roundingModesFromPb = map[pb.RoundingMode]models.RoundingMode{
+	pb.RoundingMode_ROUNDING_MODE_HALF_EVEN: models.RoundingHalfEven,
+	pb.RoundingMode_ROUNDING_MODE_FLOOR:     models.RoundingFloor,
+	pb.RoundingMode_ROUNDING_MODE_CEIL:      models.RoundingCeil,
+}
+
+var storeModesToPb = map[models.StoreMode]pb.StoreMode{
+	models.StoreAll:      pb.StoreMode_STORE_MODE_ALL,
+	models.StoreOnChange: pb.StoreMode_STORE_MODE_ON_CHANGE,
+}
+
+var storeModesFromPb = map[pb.StoreMode]models.StoreMode{
+	pb.StoreMode_STORE_MODE_ALL:       models.StoreAll,
+	pb.StoreMode_STORE_MODE_ON_CHANGE: models.StoreOnChange,
+}
+
+func marketToPb(market *models.Market) *pb.Market {
+	return &pb.Market{
+		Symbol:            market.Symbol,
+		BaseCurrency:      market.BaseCurrency,
+		QuoteCurrency:     market.QuoteCurrency,
+		Provider:          market.Provider,
+		Precision:         market.Precision,
+		Rounding:          roundingModesToPb[market.Rounding],
+		PollingInterval:   int64(market.PollingInterval / time.Second),
+		StalenessLimit:    int64(market.StalenessLimit / time.Second),
+		Enabled:           market.Enabled,
+		CreatedAt:         market.CreatedAt.Unix(),
+		UpdatedAt:         market.UpdatedAt.Unix(),
+		StoreMode:         storeModesToPb[market.StoreMode],
+		HeartbeatInterval: int64(market.HeartbeatInterval / time.Second),
+	}
+}
+
+func marketFromPb(market *pb.Market) *models.Market {
+	return &models.Market{
+		Symbol:            market.GetSymbol(),
+		BaseCurrency:      market.GetBaseCurrency(),
+		QuoteCurrency:     market.GetQuoteCurrency(),
+		Provider:          market.GetProvider(),
+		Precision:         market.GetPrecision(),
+		Rounding:          roundingModesFromPb[market.GetRounding()],
+		PollingInterval:   time.Duration(market.GetPollingInterval()) * time.Second,
+		StalenessLimit:    time.Duration(market.GetStalenessLimit()) * time.Second,
+		Enabled:           market.GetEnabled(),
+		StoreMode:         storeModesFromPb[market.GetStoreMode()],
+		HeartbeatInterval: time.Duration(market.GetHeartbeatInterval()) * time.Second,
+	}
+}
@@ -0,0 +1,28 @@
+package exchangerateservice
+
+import (
+	"google.golang.org/genproto/googleapis/type/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func orderBookLevelsToPb(levels []models.OrderBookLevel) []*pb.OrderBookLevel {
+	resp := make([]*pb.OrderBookLevel, 0, len(levels))
+
+	for _, level := range levels {
+		resp = append(resp, &pb.OrderBookLevel{
+			Price: &decimal.Decimal{
+				Value: level.Price.String(),
+			},
+			Volume: &decimal.Decimal{
+				Value: level.Volume.String(),
+			},
+			Amount: &decimal.Decimal{
+				Value: level.Amount.String(),
+			},
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,63 @@
+package exchangerateservice
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+var errPageTokenMismatch = errors.New("page token does not match the request")
+
+// pageToken is the signed content of ListRates page tokens. The filter is kept
+// alongside the cursor so a token cannot be replayed against another query, the
+// resolution picked on the first page is kept for the following ones.
+type pageToken struct {
+	Market     string            `json:"m"`
+	From       int64             `json:"f"`
+	To         int64             `json:"t"`
+	Desc       bool              `json:"d"`
+	After      models.RateCursor `json:"a"`
+	Resolution models.Resolution `json:"r"`
+}
+
+func (s *ExchangeRateService) encodePageToken(filter models.RateFilter, after *models.RateCursor) (string, error) {
+	if after == nil {
+		return "", nil
+	}
+
+	payload, err := json.Marshal(pageToken{
+		Market:     filter.Market,
+		From:       filter.From,
+		To:         filter.To,
+		Desc:       filter.Desc,
+		After:      *after,
+		Resolution: filter.Resolution,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return s.pageTokenSigner.Sign(payload), nil
+}
+
+func (s *ExchangeRateService) decodePageToken(token string, filter *models.RateFilter) error {
+	payload, err := s.pageTokenSigner.Verify(token)
+	if err != nil {
+		return err
+	}
+
+	var pt pageToken
+	if err = json.Unmarshal(payload, &pt); err != nil {
+		return err
+	}
+
+	if pt.Market != filter.Market || pt.From != filter.From || pt.To != filter.To || pt.Desc != filter.Desc {
+		return errPageTokenMismatch
+	}
+
+	filter.After = &pt.After
+	filter.Resolution = pt.Resolution
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package exchangerateservice
+
+import (
+	"google.golang.org/genproto/googleapis/type/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func quoteToPb(quote *models.Quote) *pb.Quote {
+	var redeemedAt int64
+	if !quote.RedeemedAt.IsZero() {
+		redeemedAt = quote.RedeemedAt.Unix()
+	}
+
+	return &pb.Quote{
+		Id:     quote.ID,
+		Market: quote.Market,
+		Amount: &decimal.Decimal{
+			Value: quote.Amount.String(),
+		},
+		AskPrice: &decimal.Decimal{
+			Value: quote.AskPrice.String(),
+		},
+		BidPrice: &decimal.Decimal{
+			Value: quote.BidPrice.String(),
+		},
+		RawAskPrice: &decimal.Decimal{
+			Value: quote.RawAskPrice.String(),
+		},
+		RawBidPrice: &decimal.Decimal{
+			Value: quote.RawBidPrice.String(),
+		},
+		PricingRuleId: quote.PricingRuleID,
+		RateTs:        quote.RateTS,
+		CreatedAt:     quote.CreatedAt.Unix(),
+		ExpiresAt:     quote.ExpiresAt.Unix(),
+		RedeemedAt:    redeemedAt,
+	}
+}
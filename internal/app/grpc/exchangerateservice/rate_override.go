@@ -0,0 +1,29 @@
+package exchangerateservice
+
+import (
+	"google.golang.org/genproto/googleapis/type/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func rateOverrideToPb(override *models.RateOverride) *pb.RateOverride {
+	var expiresAt int64
+	if !override.ExpiresAt.IsZero() {
+		expiresAt = override.ExpiresAt.Unix()
+	}
+
+	return &pb.RateOverride{
+		Market: override.Market,
+		AskPrice: &decimal.Decimal{
+			Value: override.AskPrice.String(),
+		},
+		BidPrice: &decimal.Decimal{
+			Value: override.BidPrice.String(),
+		},
+		Reason:    override.Reason,
+		Actor:     override.Actor,
+		ExpiresAt: expiresAt,
+		CreatedAt: override.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,76 @@
+package exchangerateservice
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) BatchGetRates(ctx context.Context, req *pb.BatchGetRatesRequest) (*pb.BatchGetRatesResponse, error) {
+	if err := s.validateBatchGetRatesReq(req); err != nil {
+		return nil, err
+	}
+
+	results := s.exchangeRateModule.BatchGetExchangeRates(ctx, utils.ClientIDFromContext(ctx), req.GetMarkets())
+
+	resp := &pb.BatchGetRatesResponse{
+		Results: make([]*pb.MarketRate, 0, len(results)),
+	}
+
+	for _, result := range results {
+		if result.Err != nil {
+			resp.Results = append(resp.Results, &pb.MarketRate{
+				Market: result.Market,
+				Status: fetchRateStatus(result.Market, result.Err).Proto(),
+			})
+
+			continue
+		}
+
+		resp.Results = append(resp.Results, &pb.MarketRate{
+			Market: result.Market,
+			Status: status.New(codes.OK, "").Proto(),
+			Ts:     result.Quote.Rate.TS,
+			AskPrice: &decimal.Decimal{
+				Value: result.Quote.AskPrice.String(),
+			},
+			BidPrice: &decimal.Decimal{
+				Value: result.Quote.BidPrice.String(),
+			},
+			Cached: result.Cached,
+			RawAskPrice: &decimal.Decimal{
+				Value: result.Quote.Rate.AskPrice.String(),
+			},
+			RawBidPrice: &decimal.Decimal{
+				Value: result.Quote.Rate.BidPrice.String(),
+			},
+			PricingRuleId: result.Quote.RuleID,
+			Overridden:    result.Quote.Overridden,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *ExchangeRateService) validateBatchGetRatesReq(req *pb.BatchGetRatesRequest) error {
+	markets := slices.Clone(req.GetMarkets())
+	slices.Sort(markets)
+	markets = slices.Compact(markets)
+
+	switch {
+	case len(markets) == 0:
+		return status.Errorf(codes.InvalidArgument, "markets are required")
+	case slices.Contains(markets, ""):
+		return status.Errorf(codes.InvalidArgument, "market must not be empty")
+	case len(markets) > s.batchMaxMarkets:
+		return status.Errorf(codes.InvalidArgument, "at most %d markets are allowed", s.batchMaxMarkets)
+	default:
+		return nil
+	}
+}
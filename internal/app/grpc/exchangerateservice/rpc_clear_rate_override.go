@@ -0,0 +1,35 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) ClearRateOverride(ctx context.Context, req *pb.ClearRateOverrideRequest) (*pb.ClearRateOverrideResponse, error) {
+	actor := utils.ActorFromContext(ctx)
+
+	switch {
+	case actor == "":
+		return nil, status.Error(codes.Unauthenticated, "admin identity is required")
+	case req.GetMarket() == "":
+		return nil, status.Errorf(codes.InvalidArgument, "market is required")
+	}
+
+	err := s.exchangeRateModule.ClearRateOverride(ctx, req.GetMarket(), actor, req.GetReason())
+	if err != nil {
+		if errors.Is(err, models.ErrOverrideNotFound) {
+			return nil, status.Errorf(codes.NotFound, "market %s has no rate override", req.GetMarket())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to clear rate override: %v", err)
+	}
+
+	return &pb.ClearRateOverrideResponse{}, nil
+}
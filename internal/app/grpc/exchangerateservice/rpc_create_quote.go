@@ -0,0 +1,52 @@
+package exchangerateservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) CreateQuote(ctx context.Context, req *pb.CreateQuoteRequest) (*pb.CreateQuoteResponse, error) {
+	amount, err := validateCreateQuoteReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.quoteModule.CreateQuote(
+		ctx,
+		req.GetMarket(),
+		utils.ClientIDFromContext(ctx),
+		amount,
+		time.Duration(req.GetTtlSeconds())*time.Second,
+	)
+	if err != nil {
+		return nil, fetchRateStatus(req.GetMarket(), err).Err()
+	}
+
+	return &pb.CreateQuoteResponse{
+		Quote: quoteToPb(quote),
+	}, nil
+}
+
+func validateCreateQuoteReq(req *pb.CreateQuoteRequest) (decimal.Decimal, error) {
+	if req.GetMarket() == "" {
+		return decimal.Zero, status.Errorf(codes.InvalidArgument, "market is required")
+	}
+
+	if req.GetTtlSeconds() < 0 {
+		return decimal.Zero, status.Errorf(codes.InvalidArgument, "ttl_seconds must not be negative")
+	}
+
+	amount, err := decimal.NewFromString(req.GetAmount().GetValue())
+	if err != nil || !amount.IsPositive() {
+		return decimal.Zero, status.Errorf(codes.InvalidArgument, "amount must be a positive decimal")
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,105 @@
+package exchangerateservice
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) ExportRates(req *pb.ExportRatesRequest, stream pb.ExchangeRateService_ExportRatesServer) error {
+	loc, err := time.LoadLocation(req.GetTimezone())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "unknown timezone %q", req.GetTimezone())
+	}
+
+	format, ok := exportFormatsFromPb[req.GetFormat()]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown format %v", req.GetFormat())
+	}
+
+	w := &chunkWriter{
+		stream: stream,
+		buf:    make([]byte, 0, s.exportModule.ChunkSize()),
+	}
+
+	_, err = s.exportModule.ExportRates(stream.Context(), &models.ExportRequest{
+		Markets:  req.GetMarkets(),
+		From:     req.GetFrom(),
+		To:       req.GetTo(),
+		Format:   format,
+		Columns:  req.GetColumns(),
+		Location: loc,
+	}, w)
+	if err == nil {
+		err = w.flush()
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrInvalidExport):
+			return status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, models.ErrStorageUnavailable):
+			return status.Error(codes.Unavailable, "rate history is unavailable")
+		case stream.Context().Err() != nil:
+			// The client went away or the deadline passed.
+			return status.FromContextError(stream.Context().Err()).Err()
+		default:
+			return status.Errorf(codes.Internal, "failed to export rates: %v", err)
+		}
+	}
+
+	return nil
+}
+
+var exportFormatsFromPb = map[pb.ExportFormat]models.ExportFormat{
+	pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED: models.ExportFormatCSV,
+	pb.ExportFormat_EXPORT_FORMAT_CSV:         models.ExportFormatCSV,
+	pb.ExportFormat_EXPORT_FORMAT_PARQUET:     models.ExportFormatParquet,
+}
+
+// chunkWriter sends the written file in chunks of up to the capacity of buf.
+type chunkWriter struct {
+	stream pb.ExchangeRateService_ExportRatesServer
+	buf    []byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		free := cap(w.buf) - len(w.buf)
+		if free == 0 {
+			if err := w.flush(); err != nil {
+				return n - len(p), err
+			}
+
+			continue
+		}
+
+		free = min(free, len(p))
+		w.buf = append(w.buf, p[:free]...)
+		p = p[free:]
+	}
+
+	return n, nil
+}
+
+// flush sends the buffered part of the file.
+func (w *chunkWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	if err := w.stream.Send(&pb.ExportRatesResponse{Data: w.buf}); err != nil {
+		return err
+	}
+
+	w.buf = w.buf[:0]
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) GetMarket(ctx context.Context, req *pb.GetMarketRequest) (*pb.GetMarketResponse, error) {
+	if req.GetSymbol() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "symbol is required")
+	}
+
+	market, err := s.exchangeRateModule.GetMarket(ctx, req.GetSymbol())
+	if err != nil {
+		if errors.Is(err, models.ErrMarketNotFound) {
+			return nil, status.Errorf(codes.NotFound, "market %s is not registered", req.GetSymbol())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get market: %v", err)
+	}
+
+	return &pb.GetMarketResponse{
+		Market: marketToPb(market),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) GetOrderBookAt(ctx context.Context, req *pb.GetOrderBookAtRequest) (*pb.GetOrderBookAtResponse, error) {
+	if err := validateGetOrderBookAtReq(req); err != nil {
+		return nil, err
+	}
+
+	maxStaleness := time.Duration(req.GetMaxStaleness()) * time.Second
+
+	book, err := s.exchangeRateModule.GetOrderBookAt(ctx, req.GetMarket(), req.GetTs(), maxStaleness)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrOrderBookNotFound):
+			return nil, status.Errorf(codes.NotFound, "no order book for market %s at or before %d within the staleness limit", req.GetMarket(), req.GetTs())
+		case errors.Is(err, models.ErrStorageUnavailable):
+			return nil, status.Error(codes.Unavailable, "rate history is unavailable")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get order book: %v", err)
+	}
+
+	return &pb.GetOrderBookAtResponse{
+		Market: book.Market,
+		Ts:     book.TS,
+		Asks:   orderBookLevelsToPb(book.Asks),
+		Bids:   orderBookLevelsToPb(book.Bids),
+	}, nil
+}
+
+func validateGetOrderBookAtReq(req *pb.GetOrderBookAtRequest) error {
+	switch {
+	case req.GetMarket() == "":
+		return status.Errorf(codes.InvalidArgument, "market is required")
+	case req.GetTs() <= 0:
+		return status.Errorf(codes.InvalidArgument, "ts must be positive")
+	case req.GetMaxStaleness() < 0:
+		return status.Errorf(codes.InvalidArgument, "max_staleness must not be negative")
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) GetRateAt(ctx context.Context, req *pb.GetRateAtRequest) (*pb.GetRateAtResponse, error) {
+	if err := validateGetRateAtReq(req); err != nil {
+		return nil, err
+	}
+
+	maxStaleness := time.Duration(req.GetMaxStaleness()) * time.Second
+
+	rate, err := s.exchangeRateModule.GetExchangeRateAt(ctx, req.GetMarket(), req.GetTs(), maxStaleness)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrRateNotFound):
+			return nil, status.Errorf(codes.NotFound, "no rate for market %s at or before %d within the staleness limit", req.GetMarket(), req.GetTs())
+		case errors.Is(err, models.ErrStorageUnavailable):
+			return nil, status.Error(codes.Unavailable, "rate history is unavailable")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get rate: %v", err)
+	}
+
+	return &pb.GetRateAtResponse{
+		Ts: rate.TS,
+		AskPrice: &decimal.Decimal{
+			Value: rate.AskPrice.String(),
+		},
+		BidPrice: &decimal.Decimal{
+			Value: rate.BidPrice.String(),
+		},
+	}, nil
+}
+
+func validateGetRateAtReq(req *pb.GetRateAtRequest) error {
+	switch {
+	case req.GetMarket() == "":
+		return status.Errorf(codes.InvalidArgument, "market is required")
+	case req.GetTs() <= 0:
+		return status.Errorf(codes.InvalidArgument, "ts must be positive")
+	case req.GetMaxStaleness() < 0:
+		return status.Errorf(codes.InvalidArgument, "max_staleness must not be negative")
+	default:
+		return nil
+	}
+}
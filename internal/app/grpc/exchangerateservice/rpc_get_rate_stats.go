@@ -0,0 +1,92 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) GetRateStats(ctx context.Context, req *pb.GetRateStatsRequest) (*pb.GetRateStatsResponse, error) {
+	if err := validateGetRateStatsReq(req); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.exchangeRateModule.GetRateStats(ctx, req.GetMarket(), req.GetFrom(), req.GetTo(), req.GetPercentiles())
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrRateNotFound):
+			return nil, status.Errorf(codes.NotFound, "no rates for market %s in the window", req.GetMarket())
+		case errors.Is(err, models.ErrStatsWindowTooLarge):
+			return nil, status.Error(codes.InvalidArgument, "window has too many samples, narrow it down")
+		case errors.Is(err, models.ErrStorageUnavailable):
+			return nil, status.Error(codes.Unavailable, "rate history is unavailable")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to compute stats: %v", err)
+		}
+	}
+
+	return &pb.GetRateStatsResponse{
+		Market:     stats.Market,
+		From:       stats.From,
+		To:         stats.To,
+		Samples:    int64(stats.Samples),
+		Source:     stats.Source,
+		Bid:        priceStatsToPb(&stats.Bid),
+		Ask:        priceStatsToPb(&stats.Ask),
+		Mid:        priceStatsToPb(&stats.Mid),
+		Resolution: resolutionsToPb[stats.Resolution],
+	}, nil
+}
+
+func validateGetRateStatsReq(req *pb.GetRateStatsRequest) error {
+	switch {
+	case req.GetMarket() == "":
+		return status.Errorf(codes.InvalidArgument, "market is required")
+	case req.GetFrom() <= 0:
+		return status.Errorf(codes.InvalidArgument, "from must be positive")
+	case req.GetTo() != 0 && req.GetTo() <= req.GetFrom():
+		return status.Errorf(codes.InvalidArgument, "to must be greater than from")
+	}
+
+	for _, p := range req.GetPercentiles() {
+		// Written so that NaN is rejected too.
+		if !(p > 0 && p <= 100) {
+			return status.Errorf(codes.InvalidArgument, "percentile %v is out of (0, 100]", p)
+		}
+	}
+
+	return nil
+}
+
+func priceStatsToPb(stats *models.PriceStats) *pb.PriceStats {
+	resp := &pb.PriceStats{
+		Twap: &decimal.Decimal{
+			Value: stats.TWAP.String(),
+		},
+		Min: &decimal.Decimal{
+			Value: stats.Min.String(),
+		},
+		Max: &decimal.Decimal{
+			Value: stats.Max.String(),
+		},
+		Volatility:  stats.Volatility,
+		Percentiles: make([]*pb.PercentileValue, 0, len(stats.Percentiles)),
+	}
+
+	for _, p := range stats.Percentiles {
+		resp.Percentiles = append(resp.Percentiles, &pb.PercentileValue{
+			Percentile: p.Percentile,
+			Value: &decimal.Decimal{
+				Value: p.Value.String(),
+			},
+		})
+	}
+
+	return resp
+}
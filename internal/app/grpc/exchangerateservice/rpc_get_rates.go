@@ -5,43 +5,73 @@ import (
 	"errors"
 	"github.com/KVSH-user/ExchangeRateService/internal/adapters/garantex"
 
+	shopspring "github.com/shopspring/decimal"
 	"google.golang.org/genproto/googleapis/type/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
 	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
 )
 
 func (s *ExchangeRateService) GetRates(ctx context.Context, req *pb.GetRatesRequest) (*pb.GetRatesResponse, error) {
-	if err := validateGetRatesReq(req); err != nil {
+	amount, err := validateGetRatesReq(req)
+	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	rate, err := s.exchangeRateModule.GetExchangeRate(ctx, req.GetMarket())
+	quoted, err := s.exchangeRateModule.QuoteExchangeRate(ctx, req.GetMarket(), utils.ClientIDFromContext(ctx), amount)
 	if err != nil {
-		if errors.As(err, &garantex.ErrInvalidMarketID) {
-			return nil, status.Error(codes.InvalidArgument, "Invalid marketID")
-		}
-
-		return nil, status.Errorf(codes.Internal, "failed to fetch rates: %v", err)
+		return nil, fetchRateStatus(req.GetMarket(), err).Err()
 	}
 
 	return &pb.GetRatesResponse{
-		Ts: rate.TS,
+		Ts: quoted.Rate.TS,
 		AskPrice: &decimal.Decimal{
-			Value: rate.AskPrice.String(),
+			Value: quoted.AskPrice.String(),
 		},
 		BidPrice: &decimal.Decimal{
-			Value: rate.BidPrice.String(),
+			Value: quoted.BidPrice.String(),
+		},
+		RawAskPrice: &decimal.Decimal{
+			Value: quoted.Rate.AskPrice.String(),
 		},
+		RawBidPrice: &decimal.Decimal{
+			Value: quoted.Rate.BidPrice.String(),
+		},
+		PricingRuleId: quoted.RuleID,
+		Overridden:    quoted.Overridden,
 	}, nil
 }
 
-func validateGetRatesReq(req *pb.GetRatesRequest) error {
+// fetchRateStatus maps an error of fetching the rate of the market to a gRPC status.
+func fetchRateStatus(market string, err error) *status.Status {
 	switch {
-	case req.GetMarket() == "":
-		return status.Errorf(codes.InvalidArgument, "market is required")
+	case errors.Is(err, garantex.ErrInvalidMarketID):
+		return status.New(codes.InvalidArgument, "Invalid marketID")
+	case errors.Is(err, models.ErrMarketNotFound):
+		return status.Newf(codes.NotFound, "market %s is not registered", market)
+	case errors.Is(err, models.ErrMarketDisabled):
+		return status.Newf(codes.FailedPrecondition, "market %s is disabled", market)
 	default:
-		return nil
+		return status.Newf(codes.Internal, "failed to fetch rates: %v", err)
+	}
+}
+
+func validateGetRatesReq(req *pb.GetRatesRequest) (shopspring.Decimal, error) {
+	if req.GetMarket() == "" {
+		return shopspring.Zero, status.Errorf(codes.InvalidArgument, "market is required")
+	}
+
+	if req.GetAmount().GetValue() == "" {
+		return shopspring.Zero, nil
 	}
+
+	amount, err := shopspring.NewFromString(req.GetAmount().GetValue())
+	if err != nil || amount.IsNegative() {
+		return shopspring.Zero, status.Errorf(codes.InvalidArgument, "invalid amount %q", req.GetAmount().GetValue())
+	}
+
+	return amount, nil
 }
@@ -6,8 +6,20 @@ import (
 	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
 )
 
-func (s *ExchangeRateService) HealthCheck(_ context.Context, _ *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
+// HealthCheck reports OK while the storage is up. With the storage down the live
+// rates are still served, the service reports DEGRADED.
+func (s *ExchangeRateService) HealthCheck(ctx context.Context, _ *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
+	if err := s.exchangeRateModule.PingStorage(ctx); err != nil {
+		s.logger.WarnContext(ctx, "storage is down", "error", err)
+
+		return &pb.HealthCheckResponse{
+			Status:  "DEGRADED",
+			Storage: "DOWN",
+		}, nil
+	}
+
 	return &pb.HealthCheckResponse{
-		Status: "OK",
+		Status:  "OK",
+		Storage: "UP",
 	}, nil
 }
@@ -0,0 +1,27 @@
+package exchangerateservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) ListMarkets(ctx context.Context, _ *pb.ListMarketsRequest) (*pb.ListMarketsResponse, error) {
+	markets, err := s.exchangeRateModule.ListMarkets(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list markets: %v", err)
+	}
+
+	resp := &pb.ListMarketsResponse{
+		Markets: make([]*pb.Market, 0, len(markets)),
+	}
+
+	for _, market := range markets {
+		resp.Markets = append(resp.Markets, marketToPb(market))
+	}
+
+	return resp, nil
+}
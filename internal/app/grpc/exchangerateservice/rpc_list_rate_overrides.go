@@ -0,0 +1,27 @@
+package exchangerateservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) ListRateOverrides(ctx context.Context, _ *pb.ListRateOverridesRequest) (*pb.ListRateOverridesResponse, error) {
+	overrides, err := s.exchangeRateModule.ListRateOverrides(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list rate overrides: %v", err)
+	}
+
+	resp := &pb.ListRateOverridesResponse{
+		Overrides: make([]*pb.RateOverride, 0, len(overrides)),
+	}
+
+	for _, override := range overrides {
+		resp.Overrides = append(resp.Overrides, rateOverrideToPb(override))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,101 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) ListRates(ctx context.Context, req *pb.ListRatesRequest) (*pb.ListRatesResponse, error) {
+	if err := validateListRatesReq(req); err != nil {
+		return nil, err
+	}
+
+	filter := models.RateFilter{
+		Market: req.GetMarket(),
+		From:   req.GetFrom(),
+		To:     req.GetTo(),
+		Desc:   req.GetOrder() == pb.SortOrder_SORT_ORDER_DESC,
+		Limit:  int(req.GetPageSize()),
+	}
+
+	if req.GetPageToken() != "" {
+		if err := s.decodePageToken(req.GetPageToken(), &filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+	} else {
+		filter.Resolution = s.exchangeRateModule.HistoryResolution(filter.Market, filter.From)
+	}
+
+	rates, next, err := s.exchangeRateModule.ListExchangeRates(ctx, filter)
+	if err != nil {
+		if errors.Is(err, models.ErrStorageUnavailable) {
+			return nil, status.Error(codes.Unavailable, "rate history is unavailable")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to list rates: %v", err)
+	}
+
+	nextPageToken, err := s.encodePageToken(filter, next)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode page token: %v", err)
+	}
+
+	resp := &pb.ListRatesResponse{
+		Rates:         make([]*pb.ExchangeRate, 0, len(rates)),
+		NextPageToken: nextPageToken,
+		Resolution:    resolutionsToPb[filter.Resolution],
+	}
+
+	for _, rate := range rates {
+		resp.Rates = append(resp.Rates, exchangeRateToPb(rate))
+	}
+
+	return resp, nil
+}
+
+func validateListRatesReq(req *pb.ListRatesRequest) error {
+	switch {
+	case req.GetMarket() == "":
+		return status.Errorf(codes.InvalidArgument, "market is required")
+	case req.GetFrom() < 0 || req.GetTo() < 0:
+		return status.Errorf(codes.InvalidArgument, "from and to must not be negative")
+	case req.GetTo() != 0 && req.GetTo() <= req.GetFrom():
+		return status.Errorf(codes.InvalidArgument, "to must be greater than from")
+	case req.GetPageSize() < 0:
+		return status.Errorf(codes.InvalidArgument, "page_size must not be negative")
+	default:
+		return nil
+	}
+}
+
+var resolutionsToPb = map[models.Resolution]pb.Resolution{
+	models.ResolutionRaw:    pb.Resolution_RESOLUTION_RAW,
+	models.ResolutionMinute: pb.Resolution_RESOLUTION_1M,
+	models.ResolutionHour:   pb.Resolution_RESOLUTION_1H,
+}
+
+func exchangeRateToPb(rate *models.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		Id:     rate.ID,
+		Market: rate.Market,
+		Ts:     rate.TS,
+		AskPrice: &decimal.Decimal{
+			Value: rate.AskPrice.String(),
+		},
+		BidPrice: &decimal.Decimal{
+			Value: rate.BidPrice.String(),
+		},
+		Source:    rate.Source,
+		FetchedAt: rate.FetchedAt.UnixMilli(),
+		LatencyMs: rate.Latency.Milliseconds(),
+		RequestId: rate.RequestID,
+		ValidTo:   rate.ValidTo,
+	}
+}
@@ -0,0 +1,37 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) RedeemQuote(ctx context.Context, req *pb.RedeemQuoteRequest) (*pb.RedeemQuoteResponse, error) {
+	if req.GetQuoteId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "quote_id is required")
+	}
+
+	quote, err := s.quoteModule.RedeemQuote(ctx, req.GetQuoteId(), utils.ClientIDFromContext(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrQuoteNotFound):
+			return nil, status.Errorf(codes.NotFound, "quote not found")
+		case errors.Is(err, models.ErrQuoteExpired):
+			return nil, status.Errorf(codes.FailedPrecondition, "quote is expired")
+		case errors.Is(err, models.ErrQuoteRedeemed):
+			return nil, status.Errorf(codes.FailedPrecondition, "quote is already redeemed")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to redeem quote: %v", err)
+		}
+	}
+
+	return &pb.RedeemQuoteResponse{
+		Quote: quoteToPb(quote),
+	}, nil
+}
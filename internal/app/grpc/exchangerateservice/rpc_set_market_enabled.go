@@ -0,0 +1,31 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) SetMarketEnabled(ctx context.Context, req *pb.SetMarketEnabledRequest) (*pb.SetMarketEnabledResponse, error) {
+	if req.GetSymbol() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "symbol is required")
+	}
+
+	market, err := s.exchangeRateModule.SetMarketEnabled(ctx, req.GetSymbol(), req.GetEnabled())
+	if err != nil {
+		if errors.Is(err, models.ErrMarketNotFound) {
+			return nil, status.Errorf(codes.NotFound, "market %s is not registered", req.GetSymbol())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set market enabled: %v", err)
+	}
+
+	return &pb.SetMarketEnabledResponse{
+		Market: marketToPb(market),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) SetRateOverride(ctx context.Context, req *pb.SetRateOverrideRequest) (*pb.SetRateOverrideResponse, error) {
+	override, err := validateSetRateOverrideReq(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.exchangeRateModule.SetRateOverride(ctx, override); err != nil {
+		if errors.Is(err, models.ErrInvalidOverride) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set rate override: %v", err)
+	}
+
+	return &pb.SetRateOverrideResponse{
+		Override: rateOverrideToPb(override),
+	}, nil
+}
+
+func validateSetRateOverrideReq(ctx context.Context, req *pb.SetRateOverrideRequest) (*models.RateOverride, error) {
+	actor := utils.ActorFromContext(ctx)
+
+	switch {
+	case actor == "":
+		return nil, status.Error(codes.Unauthenticated, "admin identity is required")
+	case req.GetMarket() == "":
+		return nil, status.Errorf(codes.InvalidArgument, "market is required")
+	case req.GetExpiresAt() < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "expires_at must not be negative")
+	}
+
+	ask, err := decimal.NewFromString(req.GetAskPrice().GetValue())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ask_price %q", req.GetAskPrice().GetValue())
+	}
+
+	bid, err := decimal.NewFromString(req.GetBidPrice().GetValue())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bid_price %q", req.GetBidPrice().GetValue())
+	}
+
+	override := &models.RateOverride{
+		Market:   req.GetMarket(),
+		AskPrice: ask,
+		BidPrice: bid,
+		Reason:   req.GetReason(),
+		Actor:    actor,
+	}
+
+	if req.GetExpiresAt() > 0 {
+		override.ExpiresAt = time.Unix(req.GetExpiresAt(), 0)
+	}
+
+	return override, nil
+}
@@ -0,0 +1,41 @@
+package exchangerateservice
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) SubscribeRates(req *pb.SubscribeRatesRequest, stream pb.ExchangeRateService_SubscribeRatesServer) error {
+	ctx := stream.Context()
+
+	rates, err := s.exchangeRateModule.SubscribeRates(ctx, req.GetMarkets())
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrMarketNotFound):
+			return status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, models.ErrMarketDisabled):
+			return status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, models.ErrTooManySubscribers):
+			return status.Error(codes.ResourceExhausted, "too many rate subscribers, try again later")
+		default:
+			return status.Errorf(codes.Internal, "failed to subscribe to rates: %v", err)
+		}
+	}
+
+	for rate := range rates {
+		if err = stream.Send(&pb.SubscribeRatesResponse{Rate: exchangeRateToPb(rate)}); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	return status.Error(codes.ResourceExhausted, "subscriber fell behind the rates")
+}
@@ -0,0 +1,32 @@
+package exchangerateservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
+)
+
+func (s *ExchangeRateService) UpsertMarket(ctx context.Context, req *pb.UpsertMarketRequest) (*pb.UpsertMarketResponse, error) {
+	if req.GetMarket() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "market is required")
+	}
+
+	market := marketFromPb(req.GetMarket())
+
+	if err := s.exchangeRateModule.UpsertMarket(ctx, market); err != nil {
+		if errors.Is(err, models.ErrInvalidMarket) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to upsert market: %v", err)
+	}
+
+	return &pb.UpsertMarketResponse{
+		Market: marketToPb(market),
+	}, nil
+}
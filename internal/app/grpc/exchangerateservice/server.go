@@ -2,6 +2,7 @@ package exchangerateservice
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"log/slog"
 	"net"
@@ -9,30 +10,72 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
 	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
 )
 
+const (
+	requestIDHeader = "x-request-id"
+	clientIDHeader  = "x-client-id"
+)
+
 type Server struct {
 	grpcServer *grpc.Server
 	listener   net.Listener
 	logger     *slog.Logger
 	port       string
+	adminAuth  *adminAuth
 
 	exchangeRateModule *ExchangeRateService
 }
 
-func NewServer(log *slog.Logger, port string, exchangeRateModule ExchangeRateModule) *Server {
+func NewServer(
+	log *slog.Logger,
+	cfg *config.Config,
+	exchangeRateModule ExchangeRateModule,
+	quoteModule QuoteModule,
+	exportModule ExportModule,
+) (*Server, error) {
 	if log == nil {
 		log = slog.Default()
 	}
 
+	auth, err := newAdminAuth(cfg.Admin.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(auth.tokens) == 0 {
+		log.Warn("Admin tokens are not configured, the admin RPCs are refused")
+	}
+
+	pageTokenSecret := cfg.History.PageTokenSecret
+	if pageTokenSecret == "" {
+		log.Warn("Page token secret is not configured, page tokens will not survive a restart")
+
+		pageTokenSecret = rand.Text()
+	}
+
+	service := NewExchangeRateService(
+		log,
+		exchangeRateModule,
+		quoteModule,
+		exportModule,
+		utils.NewSigner(pageTokenSecret),
+		cfg.Batch.MaxMarkets,
+	)
+
 	return &Server{
 		logger:             log,
-		port:               port,
-		exchangeRateModule: NewExchangeRateService(log, exchangeRateModule),
-	}
+		port:               cfg.GRPC.Port,
+		adminAuth:          auth,
+		exchangeRateModule: service,
+	}, nil
 }
 
 func (s *Server) Start(_ context.Context) error {
@@ -44,9 +87,17 @@ func (s *Server) Start(_ context.Context) error {
 
 	s.grpcServer = grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			s.requestIDInterceptor(),
+			s.identityInterceptor(),
+			s.adminInterceptor(),
 			s.loggingInterceptor(),
 			s.recoveryInterceptor(),
 		),
+		grpc.ChainStreamInterceptor(
+			s.streamContextInterceptor(),
+			s.streamLoggingInterceptor(),
+			s.streamRecoveryInterceptor(),
+		),
 	)
 
 	s.registerServices()
@@ -104,6 +155,9 @@ func (s *Server) loggingInterceptor() grpc.UnaryServerInterceptor {
 
 		s.logger.Log(ctx, logLevel, "gRPC call",
 			"method", info.FullMethod,
+			"request_id", utils.RequestIDFromContext(ctx),
+			"client_id", utils.ClientIDFromContext(ctx),
+			"actor", utils.ActorFromContext(ctx),
 			"duration", duration,
 			"error", err,
 		)
@@ -112,6 +166,65 @@ func (s *Server) loggingInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// requestIDInterceptor takes the request id from the incoming metadata or
+// generates a new one, puts it into the context and echoes it in the header.
+func (s *Server) requestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		requestID := incomingRequestID(ctx)
+
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+		return handler(utils.WithRequestID(ctx, requestID), req)
+	}
+}
+
+// identityInterceptor puts the client id from the incoming metadata into the
+// context, it selects the pricing rules of the client. The admin identity is only
+// set by adminInterceptor, from the authenticated token.
+func (s *Server) identityInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(withIdentity(ctx), req)
+	}
+}
+
+// adminInterceptor lets the calls of the admin RPCs through for the authenticated
+// admins only, with their actor in the context.
+func (s *Server) adminInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := adminMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		actor, err := s.adminAuth.authenticate(ctx)
+		if err != nil {
+			s.logger.WarnContext(ctx, "Admin call rejected",
+				"method", info.FullMethod,
+				"request_id", utils.RequestIDFromContext(ctx),
+				"error", err,
+			)
+
+			return nil, err
+		}
+
+		return handler(utils.WithActor(ctx, actor), req)
+	}
+}
+
 func (s *Server) recoveryInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -132,3 +245,106 @@ func (s *Server) recoveryInterceptor() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// incomingRequestID returns the request id of the incoming metadata or a new one.
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	return utils.NewRequestID()
+}
+
+// withIdentity puts the client id of the incoming metadata into ctx.
+func withIdentity(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(clientIDHeader); len(values) > 0 {
+			ctx = utils.WithClientID(ctx, values[0])
+		}
+	}
+
+	return ctx
+}
+
+// serverStream - server stream with the context prepared by the stream interceptors
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *serverStream) Context() context.Context {
+	return ss.ctx
+}
+
+// streamContextInterceptor does for the streams what requestIDInterceptor and
+// identityInterceptor do for the unary calls.
+func (s *Server) streamContextInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		requestID := incomingRequestID(ss.Context())
+
+		_ = ss.SetHeader(metadata.Pairs(requestIDHeader, requestID))
+
+		ctx := withIdentity(utils.WithRequestID(ss.Context(), requestID))
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (s *Server) streamLoggingInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		ctx := ss.Context()
+
+		logLevel := slog.LevelInfo
+		if err != nil {
+			logLevel = slog.LevelError
+		}
+
+		s.logger.Log(ctx, logLevel, "gRPC stream",
+			"method", info.FullMethod,
+			"request_id", utils.RequestIDFromContext(ctx),
+			"client_id", utils.ClientIDFromContext(ctx),
+			"actor", utils.ActorFromContext(ctx),
+			"duration", time.Since(start),
+			"error", err,
+		)
+
+		return err
+	}
+}
+
+func (s *Server) streamRecoveryInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				s.logger.Error("gRPC panic recovered",
+					"method", info.FullMethod,
+					"panic", r,
+				)
+				err = status.Errorf(codes.Internal, "%v", r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
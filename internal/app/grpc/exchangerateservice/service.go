@@ -2,9 +2,14 @@ package exchangerateservice
 
 import (
 	"context"
+	"io"
 	"log/slog"
+	"time"
+
+	"github.com/shopspring/decimal"
 
 	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
 
 	pb "github.com/KVSH-user/ExchangeRateService/pkg/pb/exchangerateservice"
 )
@@ -13,15 +18,56 @@ type ExchangeRateService struct {
 	pb.UnimplementedExchangeRateServiceServer
 	logger             *slog.Logger
 	exchangeRateModule ExchangeRateModule
+	quoteModule        QuoteModule
+	exportModule       ExportModule
+	pageTokenSigner    *utils.Signer
+	batchMaxMarkets    int
 }
 
 type ExchangeRateModule interface {
 	GetExchangeRate(ctx context.Context, market string) (*models.ExchangeRate, error)
+	QuoteExchangeRate(ctx context.Context, market, clientID string, amount decimal.Decimal) (*models.QuotedRate, error)
+	BatchGetExchangeRates(ctx context.Context, clientID string, markets []string) []models.MarketRateResult
+	GetExchangeRateAt(ctx context.Context, market string, ts int64, maxStaleness time.Duration) (*models.ExchangeRate, error)
+	ListExchangeRates(ctx context.Context, filter models.RateFilter) ([]*models.ExchangeRate, *models.RateCursor, error)
+	HistoryResolution(market string, ts int64) models.Resolution
+	GetRateStats(ctx context.Context, market string, from, to int64, percentiles []float64) (*models.RateStats, error)
+	GetOrderBookAt(ctx context.Context, market string, ts int64, maxStaleness time.Duration) (*models.OrderBook, error)
+	SubscribeRates(ctx context.Context, markets []string) (<-chan *models.ExchangeRate, error)
+	ListMarkets(ctx context.Context) ([]*models.Market, error)
+	GetMarket(ctx context.Context, symbol string) (*models.Market, error)
+	UpsertMarket(ctx context.Context, market *models.Market) error
+	SetMarketEnabled(ctx context.Context, symbol string, enabled bool) (*models.Market, error)
+	ListRateOverrides(ctx context.Context) ([]*models.RateOverride, error)
+	SetRateOverride(ctx context.Context, override *models.RateOverride) error
+	ClearRateOverride(ctx context.Context, market, actor, reason string) error
+	PingStorage(ctx context.Context) error
+}
+
+type QuoteModule interface {
+	CreateQuote(ctx context.Context, market, clientID string, amount decimal.Decimal, ttl time.Duration) (*models.Quote, error)
+	RedeemQuote(ctx context.Context, id, clientID string) (*models.Quote, error)
+}
+
+type ExportModule interface {
+	ExportRates(ctx context.Context, req *models.ExportRequest, w io.Writer) (int64, error)
+	ChunkSize() int
 }
 
-func NewExchangeRateService(logger *slog.Logger, exchangeRateModule ExchangeRateModule) *ExchangeRateService {
+func NewExchangeRateService(
+	logger *slog.Logger,
+	exchangeRateModule ExchangeRateModule,
+	quoteModule QuoteModule,
+	exportModule ExportModule,
+	pageTokenSigner *utils.Signer,
+	batchMaxMarkets int,
+) *ExchangeRateService {
 	return &ExchangeRateService{
 		logger:             logger,
 		exchangeRateModule: exchangeRateModule,
+		quoteModule:        quoteModule,
+		exportModule:       exportModule,
+		pageTokenSigner:    pageTokenSigner,
+		batchMaxMarkets:    batchMaxMarkets,
 	}
 }
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+)
+
+// Server exposes the Prometheus metrics of the service over HTTP.
+type Server struct {
+	httpServer *http.Server
+	logger     *slog.Logger
+	port       string
+}
+
+func NewServer(log *slog.Logger, cfg *config.Config) *Server {
+	if log == nil {
+		log = slog.Default()
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return &Server{
+		httpServer: &http.Server{
+			Addr:              cfg.Metrics.Port,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+		logger: log,
+		port:   cfg.Metrics.Port,
+	}
+}
+
+func (s *Server) Start(_ context.Context) error {
+	s.logger.Info("Starting metrics server", "port", s.port)
+
+	if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		s.logger.Warn("Force stopping metrics server")
+
+		return s.httpServer.Close()
+	}
+
+	s.logger.Info("Metrics server stopped gracefully")
+
+	return nil
+}
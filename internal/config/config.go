@@ -11,9 +11,46 @@ import (
 // Config - ...
 type Config struct {
 	Env            string         `yaml:"env" env:"EXCHANGE_ENV"`
+	Storage        Storage        `yaml:"storage" env:",inline"`
 	Postgres       PostgreSQL     `yaml:"postgres" env:",inline"`
 	GRPC           GRPC           `yaml:"grpc" env:",inline"`
 	GarantexClient GarantexClient `yaml:"garantex_client" env:",inline"`
+	History        History        `yaml:"history" env:",inline"`
+	Stats          Stats          `yaml:"stats" env:",inline"`
+	Markets        Markets        `yaml:"markets" env:",inline"`
+	Batch          Batch          `yaml:"batch" env:",inline"`
+	RateWriter     RateWriter     `yaml:"rate_writer" env:",inline"`
+	Metrics        Metrics        `yaml:"metrics" env:",inline"`
+	Pricing        Pricing        `yaml:"pricing" env:",inline"`
+	Quotes         Quotes         `yaml:"quotes" env:",inline"`
+	Overrides      Overrides      `yaml:"overrides" env:",inline"`
+	Retention      Retention      `yaml:"retention" env:",inline"`
+	Rollup         Rollup         `yaml:"rollup" env:",inline"`
+	Outbox         Outbox         `yaml:"outbox" env:",inline"`
+	OrderBook      OrderBook      `yaml:"order_book" env:",inline"`
+	RateQueue      RateQueue      `yaml:"rate_queue" env:",inline"`
+	Export         Export         `yaml:"export" env:",inline"`
+	Import         Import         `yaml:"import" env:",inline"`
+	RateFanout     RateFanout     `yaml:"rate_fanout" env:",inline"`
+	Admin          Admin          `yaml:"admin" env:",inline"`
+}
+
+// Storage - selects the storage backend. The sqlite and memory drivers are meant for
+// local development: markets, overrides and quotes are kept in memory, sqlite keeps
+// the rate history across restarts.
+type Storage struct {
+	// Driver - "postgres", "sqlite" or "memory"
+	Driver string `yaml:"driver" env:"EXCHANGE_STORAGE_DRIVER" env-default:"postgres"`
+	// SQLitePath - database file of the sqlite driver
+	SQLitePath string `yaml:"sqlite_path" env:"EXCHANGE_STORAGE_SQLITE_PATH" env-default:"data/exchangerate.db"`
+	// ConnectAttempts - attempts to open the storage on startup before giving up
+	ConnectAttempts int `yaml:"connect_attempts" env:"EXCHANGE_STORAGE_CONNECT_ATTEMPTS" env-default:"10"`
+	// ConnectBackoff - pause after the first failed attempt, doubled after every next one
+	ConnectBackoff time.Duration `yaml:"connect_backoff" env:"EXCHANGE_STORAGE_CONNECT_BACKOFF" env-default:"1s"`
+	// ConnectMaxBackoff - upper bound of the pause between the attempts
+	ConnectMaxBackoff time.Duration `yaml:"connect_max_backoff" env:"EXCHANGE_STORAGE_CONNECT_MAX_BACKOFF" env-default:"30s"`
+	// DegradedMode - start without the storage once the attempts are exhausted, serving live rates only
+	DegradedMode bool `yaml:"degraded_mode" env:"EXCHANGE_STORAGE_DEGRADED_MODE" env-default:"false"`
 }
 
 // PostgreSQL - ...
@@ -29,6 +66,17 @@ type PostgreSQL struct {
 	MaxConnIdleTime   time.Duration `yaml:"max_conn_idle_time" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_MAX_CONN_IDLE_TIME" env-default:"30m"`
 	HealthcheckPeriod time.Duration `yaml:"healthcheck_period" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_HEALTHCHECK_PERIOD" env-default:"1m"`
 	ConnectTimeout    time.Duration `yaml:"connect_timeout" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_CONNECT_TIMEOUT" env-default:"5s"`
+	// Replicas - host:port of the read replicas serving the history queries, empty routes them to master
+	Replicas []string `yaml:"replicas" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_REPLICAS" env-separator:","`
+	// ReplicaMaxLag - replicas lagging behind master for longer are not used until they catch up
+	ReplicaMaxLag        time.Duration `yaml:"replica_max_lag" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_REPLICA_MAX_LAG" env-default:"10s"`
+	ReplicaCheckInterval time.Duration `yaml:"replica_check_interval" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_REPLICA_CHECK_INTERVAL" env-default:"5s"`
+	// AutoMigrate - apply the pending migrations on startup, otherwise they are applied with the migrate command
+	AutoMigrate bool `yaml:"auto_migrate" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_AUTO_MIGRATE" env-default:"true"`
+	// MigrationLockTimeout - how long to wait for another replica applying the migrations
+	MigrationLockTimeout time.Duration `yaml:"migration_lock_timeout" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_MIGRATION_LOCK_TIMEOUT" env-default:"5m"`
+	// SlowQueryThreshold - statements running longer are logged with their arguments, 0 turns the log off
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold" env:"EXCHANGE_RATE_SERVICE_POSTGRESQL_SLOW_QUERY_THRESHOLD" env-default:"500ms"`
 }
 
 // GRPC - ...
@@ -41,6 +89,209 @@ type GarantexClient struct {
 	Timeout time.Duration `yaml:"timeout" env:"EXCHANGE_GARANTEX_CLIENT_TIMEOUT"`
 }
 
+// History - settings of the stored rate history lookups
+type History struct {
+	MaxStaleness    time.Duration `yaml:"max_staleness" env:"EXCHANGE_HISTORY_MAX_STALENESS" env-default:"24h"`
+	DefaultPageSize int           `yaml:"default_page_size" env:"EXCHANGE_HISTORY_DEFAULT_PAGE_SIZE" env-default:"100"`
+	MaxPageSize     int           `yaml:"max_page_size" env:"EXCHANGE_HISTORY_MAX_PAGE_SIZE" env-default:"1000"`
+	PageTokenSecret string        `yaml:"page_token_secret" env:"EXCHANGE_HISTORY_PAGE_TOKEN_SECRET"`
+}
+
+// Stats - settings of the rate statistics
+type Stats struct {
+	RecentWindow   time.Duration `yaml:"recent_window" env:"EXCHANGE_STATS_RECENT_WINDOW" env-default:"1h"`
+	RecentMaxTicks int           `yaml:"recent_max_ticks" env:"EXCHANGE_STATS_RECENT_MAX_TICKS" env-default:"10000"`
+	MaxSamples     int           `yaml:"max_samples" env:"EXCHANGE_STATS_MAX_SAMPLES" env-default:"100000"`
+}
+
+// Markets - market registry settings. The list seeds the registry on startup,
+// markets that are already registered are left untouched.
+type Markets struct {
+	DefaultPrecision  int32         `yaml:"default_precision" env:"EXCHANGE_MARKETS_DEFAULT_PRECISION" env-default:"18"`
+	DefaultRounding   string        `yaml:"default_rounding" env:"EXCHANGE_MARKETS_DEFAULT_ROUNDING" env-default:"half_even"`
+	AllowUnregistered bool          `yaml:"allow_unregistered" env:"EXCHANGE_MARKETS_ALLOW_UNREGISTERED" env-default:"true"`
+	RefreshInterval   time.Duration `yaml:"refresh_interval" env:"EXCHANGE_MARKETS_REFRESH_INTERVAL" env-default:"10s"`
+	DefaultStoreMode  string        `yaml:"default_store_mode" env:"EXCHANGE_MARKETS_DEFAULT_STORE_MODE" env-default:"all"`
+	// DefaultHeartbeatInterval - heartbeat of the on_change markets that do not set their own
+	DefaultHeartbeatInterval time.Duration `yaml:"default_heartbeat_interval" env:"EXCHANGE_MARKETS_DEFAULT_HEARTBEAT_INTERVAL" env-default:"1m"`
+	List                     []Market      `yaml:"list"`
+}
+
+// Market - registry seed of a single market
+type Market struct {
+	Symbol          string        `yaml:"symbol"`
+	BaseCurrency    string        `yaml:"base_currency"`
+	QuoteCurrency   string        `yaml:"quote_currency"`
+	Provider        string        `yaml:"provider"`
+	Precision       *int32        `yaml:"precision"`
+	Rounding        string        `yaml:"rounding"`
+	PollingInterval time.Duration `yaml:"polling_interval"`
+	StalenessLimit  time.Duration `yaml:"staleness_limit"`
+	Enabled         *bool         `yaml:"enabled"`
+	// StoreMode - "all" or "on_change", empty uses the default
+	StoreMode         string        `yaml:"store_mode"`
+	HeartbeatInterval time.Duration `yaml:"heartbeat_interval"`
+}
+
+// Batch - settings of the batch rate requests
+type Batch struct {
+	Workers    int `yaml:"workers" env:"EXCHANGE_BATCH_WORKERS" env-default:"8"`
+	MaxMarkets int `yaml:"max_markets" env:"EXCHANGE_BATCH_MAX_MARKETS" env-default:"100"`
+}
+
+// RateWriter - settings of the write-behind batching of rate inserts
+type RateWriter struct {
+	Enabled        bool          `yaml:"enabled" env:"EXCHANGE_RATE_WRITER_ENABLED" env-default:"false"`
+	BatchSize      int           `yaml:"batch_size" env:"EXCHANGE_RATE_WRITER_BATCH_SIZE" env-default:"500"`
+	FlushInterval  time.Duration `yaml:"flush_interval" env:"EXCHANGE_RATE_WRITER_FLUSH_INTERVAL" env-default:"1s"`
+	QueueSize      int           `yaml:"queue_size" env:"EXCHANGE_RATE_WRITER_QUEUE_SIZE" env-default:"10000"`
+	EnqueueTimeout time.Duration `yaml:"enqueue_timeout" env:"EXCHANGE_RATE_WRITER_ENQUEUE_TIMEOUT" env-default:"100ms"`
+	FlushTimeout   time.Duration `yaml:"flush_timeout" env:"EXCHANGE_RATE_WRITER_FLUSH_TIMEOUT" env-default:"10s"`
+}
+
+// Metrics - settings of the metrics endpoint, an empty port disables it
+type Metrics struct {
+	Port string `yaml:"port" env:"EXCHANGE_METRICS_PORT"`
+}
+
+// Pricing - settings of the client pricing rules
+type Pricing struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval" env:"EXCHANGE_PRICING_REFRESH_INTERVAL" env-default:"10s"`
+}
+
+// Quotes - settings of the firm quotes
+type Quotes struct {
+	DefaultTTL time.Duration `yaml:"default_ttl" env:"EXCHANGE_QUOTES_DEFAULT_TTL" env-default:"30s"`
+	MaxTTL     time.Duration `yaml:"max_ttl" env:"EXCHANGE_QUOTES_MAX_TTL" env-default:"5m"`
+	// Secret - key the quote ids are signed with, quotes can not be redeemed once it changes
+	Secret string `yaml:"secret" env:"EXCHANGE_QUOTES_SECRET"`
+}
+
+// Overrides - settings of the manual rate overrides
+type Overrides struct {
+	// RefreshInterval - how long other instances may serve a changed override from cache
+	RefreshInterval time.Duration `yaml:"refresh_interval" env:"EXCHANGE_OVERRIDES_REFRESH_INTERVAL" env-default:"5s"`
+}
+
+// Retention - settings of the rates partitions and of the rate history retention
+type Retention struct {
+	// Interval - how often partitions are created ahead and expired ones are removed
+	Interval    time.Duration `yaml:"interval" env:"EXCHANGE_RETENTION_INTERVAL" env-default:"1h"`
+	PremakeDays int           `yaml:"premake_days" env:"EXCHANGE_RETENTION_PREMAKE_DAYS" env-default:"7"`
+	// Default - retention of the markets that are not listed, 0 keeps the history forever
+	Default time.Duration `yaml:"default" env:"EXCHANGE_RETENTION_DEFAULT" env-default:"0s"`
+	// Mode - "drop" deletes the expired history, "archive" moves it to the rates_archive schema
+	Mode    string            `yaml:"mode" env:"EXCHANGE_RETENTION_MODE" env-default:"drop"`
+	Markets []MarketRetention `yaml:"markets"`
+}
+
+// MarketRetention - retention of the history of a single market, 0 keeps it forever
+type MarketRetention struct {
+	Market    string        `yaml:"market"`
+	Retention time.Duration `yaml:"retention"`
+}
+
+// Rollup - settings of the rollup of the raw rates into 1-minute and hourly aggregates.
+// Raw rates are kept according to the retention settings.
+type Rollup struct {
+	Enabled bool `yaml:"enabled" env:"EXCHANGE_ROLLUP_ENABLED" env-default:"false"`
+	// Interval - how often the completed buckets are rolled up
+	Interval time.Duration `yaml:"interval" env:"EXCHANGE_ROLLUP_INTERVAL" env-default:"1m"`
+	// Delay - how long a bucket stays open for late rates after its end
+	Delay time.Duration `yaml:"delay" env:"EXCHANGE_ROLLUP_DELAY" env-default:"1m"`
+	// Chunk - span of history rolled up in a single transaction
+	Chunk time.Duration `yaml:"chunk" env:"EXCHANGE_ROLLUP_CHUNK" env-default:"24h"`
+	// MinuteRetention - retention of the 1-minute aggregates, 0 keeps them forever
+	MinuteRetention time.Duration `yaml:"minute_retention" env:"EXCHANGE_ROLLUP_MINUTE_RETENTION" env-default:"8760h"`
+	// HourRetention - retention of the hourly aggregates, 0 keeps them forever
+	HourRetention time.Duration `yaml:"hour_retention" env:"EXCHANGE_ROLLUP_HOUR_RETENTION" env-default:"0s"`
+}
+
+// Outbox - settings of the outbox of the rate events and of the relay delivering them
+type Outbox struct {
+	Enabled bool `yaml:"enabled" env:"EXCHANGE_OUTBOX_ENABLED" env-default:"false"`
+	// PollInterval - how often the relay looks for pending events
+	PollInterval time.Duration `yaml:"poll_interval" env:"EXCHANGE_OUTBOX_POLL_INTERVAL" env-default:"1s"`
+	BatchSize    int           `yaml:"batch_size" env:"EXCHANGE_OUTBOX_BATCH_SIZE" env-default:"100"`
+	// MaxAttempts - failed deliveries after which an event is dead-lettered and no longer
+	// holds back the later events of its market
+	MaxAttempts int `yaml:"max_attempts" env:"EXCHANGE_OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+	// Retention - how long the delivered events are kept
+	Retention time.Duration `yaml:"retention" env:"EXCHANGE_OUTBOX_RETENTION" env-default:"24h"`
+	// Publisher - "log" writes the events to the log, "webhook" posts them to WebhookURL
+	Publisher      string        `yaml:"publisher" env:"EXCHANGE_OUTBOX_PUBLISHER" env-default:"log"`
+	WebhookURL     string        `yaml:"webhook_url" env:"EXCHANGE_OUTBOX_WEBHOOK_URL"`
+	WebhookTimeout time.Duration `yaml:"webhook_timeout" env:"EXCHANGE_OUTBOX_WEBHOOK_TIMEOUT" env-default:"5s"`
+}
+
+// OrderBook - settings of the order book snapshots stored with the rates
+type OrderBook struct {
+	Enabled bool `yaml:"enabled" env:"EXCHANGE_ORDER_BOOK_ENABLED" env-default:"false"`
+	// Depth - number of levels of each side to keep, 0 keeps the whole book
+	Depth int `yaml:"depth" env:"EXCHANGE_ORDER_BOOK_DEPTH" env-default:"50"`
+}
+
+// RateQueue - settings of the on-disk queue keeping the rates while master is
+// unreachable, they are written in order once it is back
+type RateQueue struct {
+	Enabled bool   `yaml:"enabled" env:"EXCHANGE_RATE_QUEUE_ENABLED" env-default:"false"`
+	Dir     string `yaml:"dir" env:"EXCHANGE_RATE_QUEUE_DIR" env-default:"data/rate_queue"`
+	// SegmentSizeMB - size of a segment file, a new one is started after it
+	SegmentSizeMB int64 `yaml:"segment_size_mb" env:"EXCHANGE_RATE_QUEUE_SEGMENT_SIZE_MB" env-default:"16"`
+	// MaxSizeMB - once the segments take that much, new rates fail to be saved
+	MaxSizeMB int64 `yaml:"max_size_mb" env:"EXCHANGE_RATE_QUEUE_MAX_SIZE_MB" env-default:"1024"`
+	// ReplayInterval - how often the queued rates are written while master is unreachable
+	ReplayInterval  time.Duration `yaml:"replay_interval" env:"EXCHANGE_RATE_QUEUE_REPLAY_INTERVAL" env-default:"5s"`
+	ReplayBatchSize int           `yaml:"replay_batch_size" env:"EXCHANGE_RATE_QUEUE_REPLAY_BATCH_SIZE" env-default:"500"`
+}
+
+// Export - settings of the rate history export. The history is read in pages of
+// history.max_page_size rates.
+type Export struct {
+	MaxMarkets int `yaml:"max_markets" env:"EXCHANGE_EXPORT_MAX_MARKETS" env-default:"50"`
+	// RowGroupSize - number of rates in a row group of a Parquet file
+	RowGroupSize int64 `yaml:"row_group_size" env:"EXCHANGE_EXPORT_ROW_GROUP_SIZE" env-default:"100000"`
+	// ChunkSizeKB - maximum size of a file chunk streamed by ExportRates
+	ChunkSizeKB int `yaml:"chunk_size_kb" env:"EXCHANGE_EXPORT_CHUNK_SIZE_KB" env-default:"256"`
+}
+
+// Import - settings of the historical rates import
+type Import struct {
+	// BatchSize - number of rows loaded in a transaction, an interrupted import
+	// resumes after the last loaded batch
+	BatchSize int `yaml:"batch_size" env:"EXCHANGE_IMPORT_BATCH_SIZE" env-default:"5000"`
+	// Source - source of the imported rates that have none
+	Source string `yaml:"source" env:"EXCHANGE_IMPORT_SOURCE" env-default:"import"`
+}
+
+// RateFanout - settings of sharing the saved rates between the instances over
+// PostgreSQL LISTEN/NOTIFY, every instance then keeps the latest rates saved by the
+// others and streams them to its subscribers
+type RateFanout struct {
+	Enabled bool   `yaml:"enabled" env:"EXCHANGE_RATE_FANOUT_ENABLED" env-default:"false"`
+	Channel string `yaml:"channel" env:"EXCHANGE_RATE_FANOUT_CHANNEL" env-default:"exchange_rates"`
+	// ReconnectBackoff - pause before reconnecting the listener, doubled up to
+	// MaxReconnectBackoff while the reconnections fail
+	ReconnectBackoff    time.Duration `yaml:"reconnect_backoff" env:"EXCHANGE_RATE_FANOUT_RECONNECT_BACKOFF" env-default:"1s"`
+	MaxReconnectBackoff time.Duration `yaml:"max_reconnect_backoff" env:"EXCHANGE_RATE_FANOUT_MAX_RECONNECT_BACKOFF" env-default:"30s"`
+	// CatchUpWindow - on (re)connection the rates saved since the last received one,
+	// less the window, are read from the table; on startup the rates of the last window
+	CatchUpWindow time.Duration `yaml:"catch_up_window" env:"EXCHANGE_RATE_FANOUT_CATCH_UP_WINDOW" env-default:"1m"`
+	// MaxSubscribers - limit of the concurrent SubscribeRates streams
+	MaxSubscribers int `yaml:"max_subscribers" env:"EXCHANGE_RATE_FANOUT_MAX_SUBSCRIBERS" env-default:"1000"`
+	// SubscriberBuffer - rates kept for a subscriber, one that falls further behind is dropped
+	SubscriberBuffer int `yaml:"subscriber_buffer" env:"EXCHANGE_RATE_FANOUT_SUBSCRIBER_BUFFER" env-default:"256"`
+}
+
+// Admin - access to the admin RPCs. A call must carry "authorization: Bearer <token>"
+// with one of the tokens and is done as its actor; without tokens the admin RPCs are
+// refused.
+type Admin struct {
+	// Tokens - "<actor>:<hex SHA-256 of the token>" entries, e.g. from
+	// printf %s "$TOKEN" | sha256sum
+	Tokens []string `yaml:"tokens" env:"EXCHANGE_ADMIN_TOKENS" env-separator:","`
+}
+
 // Load - config load function
 func Load() *Config {
 	path := os.Getenv("CONFIG_PATH")
@@ -0,0 +1,22 @@
+package models
+
+import "errors"
+
+var (
+	ErrRateNotFound        = errors.New("rate not found")
+	ErrStatsWindowTooLarge = errors.New("stats window has too many samples")
+	ErrInvalidMarket       = errors.New("invalid market settings")
+	ErrMarketNotFound      = errors.New("market not found")
+	ErrMarketDisabled      = errors.New("market is disabled")
+	ErrWriteQueueFull      = errors.New("rate write queue is full")
+	ErrQuoteNotFound       = errors.New("quote not found")
+	ErrQuoteExpired        = errors.New("quote is expired")
+	ErrQuoteRedeemed       = errors.New("quote is already redeemed")
+	ErrOverrideNotFound    = errors.New("rate override not found")
+	ErrInvalidOverride     = errors.New("invalid rate override")
+	ErrOrderBookNotFound   = errors.New("order book not found")
+	ErrStorageUnavailable  = errors.New("storage is unavailable")
+	ErrInvalidExport       = errors.New("invalid export request")
+	ErrTooManySubscribers  = errors.New("too many rate subscribers")
+	ErrNoRatePartition     = errors.New("no rate partition covers the ts")
+)
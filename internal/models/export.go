@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// ExportFormat - file format of the exported rate history
+type ExportFormat string
+
+const (
+	ExportFormatCSV     ExportFormat = "csv"
+	ExportFormatParquet ExportFormat = "parquet"
+)
+
+// ExportColumns - columns of the exported rate history, in the default order
+var ExportColumns = []string{
+	"market", "ts", "ask_price", "bid_price", "source", "fetched_at", "latency_ms", "request_id", "valid_to", "id",
+}
+
+// ExportRequest - rate history to export
+type ExportRequest struct {
+	Markets []string
+	// From is inclusive, To is exclusive (Unix, seconds).
+	From   int64
+	To     int64
+	Format ExportFormat
+	// Columns are written in the given order, empty writes ExportColumns.
+	Columns []string
+	// Location the timestamps are written in, nil is UTC.
+	Location *time.Location
+}
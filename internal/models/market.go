@@ -0,0 +1,111 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxPricePrecision - number of decimal places the rates table can store
+const MaxPricePrecision = 18
+
+type RoundingMode string
+
+const (
+	RoundingHalfEven RoundingMode = "half_even"
+	RoundingFloor    RoundingMode = "floor"
+	RoundingCeil     RoundingMode = "ceil"
+)
+
+// StoreMode - policy of storing the fetched snapshots of a market
+type StoreMode string
+
+const (
+	// StoreAll stores every fetched snapshot.
+	StoreAll StoreMode = "all"
+	// StoreOnChange stores a snapshot only when the bid or ask price moves, or
+	// when the heartbeat interval has passed since the last stored one.
+	StoreOnChange StoreMode = "on_change"
+)
+
+// Market - registry entry of a market
+type Market struct {
+	Symbol        string `json:"symbol"`
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+	Provider      string `json:"provider"`
+	// Precision is the number of decimal places prices are rounded to.
+	Precision       int32         `json:"precision"`
+	Rounding        RoundingMode  `json:"rounding"`
+	PollingInterval time.Duration `json:"polling_interval"`
+	// StalenessLimit bounds the age of stored rates served by history lookups,
+	// zero means the service default.
+	StalenessLimit time.Duration `json:"staleness_limit"`
+	Enabled        bool          `json:"enabled"`
+	StoreMode      StoreMode     `json:"store_mode"`
+	// HeartbeatInterval is the longest time an unchanged snapshot goes
+	// unstored in the StoreOnChange mode.
+	HeartbeatInterval time.Duration `json:"heartbeat_interval"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+// Validate - checks that the market settings are usable
+func (m *Market) Validate() error {
+	switch {
+	case m.Symbol == "":
+		return fmt.Errorf("%w: symbol is required", ErrInvalidMarket)
+	case m.Precision < 0 || m.Precision > MaxPricePrecision:
+		return fmt.Errorf("%w: precision of %s must be in [0, %d]", ErrInvalidMarket, m.Symbol, MaxPricePrecision)
+	case m.PollingInterval < 0 || m.StalenessLimit < 0 || m.HeartbeatInterval < 0:
+		return fmt.Errorf("%w: intervals of %s must not be negative", ErrInvalidMarket, m.Symbol)
+	}
+
+	switch m.Rounding {
+	case RoundingHalfEven, RoundingFloor, RoundingCeil:
+	default:
+		return fmt.Errorf("%w: unknown rounding mode %q of %s", ErrInvalidMarket, m.Rounding, m.Symbol)
+	}
+
+	switch m.StoreMode {
+	case StoreAll:
+		return nil
+	case StoreOnChange:
+		// History lookups need a stored snapshot within the staleness limit
+		// even while the price stands still.
+		if m.HeartbeatInterval <= 0 {
+			return fmt.Errorf("%w: heartbeat interval of %s is required in the %s mode", ErrInvalidMarket, m.Symbol, m.StoreMode)
+		}
+
+		if m.StalenessLimit > 0 && m.HeartbeatInterval > m.StalenessLimit {
+			return fmt.Errorf("%w: heartbeat interval of %s exceeds its staleness limit", ErrInvalidMarket, m.Symbol)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown store mode %q of %s", ErrInvalidMarket, m.StoreMode, m.Symbol)
+	}
+}
+
+// Unchanged - reports whether rate may be left unstored in favour of the
+// previously stored snapshot prev of the market
+func (m *Market) Unchanged(prev, rate *ExchangeRate) bool {
+	return m.StoreMode == StoreOnChange &&
+		prev != nil &&
+		prev.AskPrice.Equal(rate.AskPrice) &&
+		prev.BidPrice.Equal(rate.BidPrice) &&
+		rate.TS-prev.TS < int64(m.HeartbeatInterval/time.Second)
+}
+
+// Round - rounds price to the precision of the market using its rounding mode
+func (m *Market) Round(price decimal.Decimal) decimal.Decimal {
+	switch m.Rounding {
+	case RoundingFloor:
+		return price.RoundFloor(m.Precision)
+	case RoundingCeil:
+		return price.RoundCeil(m.Precision)
+	default:
+		return price.RoundBank(m.Precision)
+	}
+}
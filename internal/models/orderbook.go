@@ -0,0 +1,75 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/shopspring/decimal"
+)
+
+// OrderBook - order book of a market as it was at TS. It is stored with the
+// rate it was taken with, so its JSON form holds only the levels.
+type OrderBook struct {
+	Market string `json:"-"`
+	// TS is the exchange timestamp of the book (Unix, seconds).
+	TS int64 `json:"-"`
+	// Asks are ordered from the best price, as are Bids.
+	Asks []OrderBookLevel `json:"asks"`
+	Bids []OrderBookLevel `json:"bids"`
+}
+
+// OrderBookLevel - price level of an order book side, encoded in JSON as
+// [price, volume, amount]
+type OrderBookLevel struct {
+	Price  decimal.Decimal
+	Volume decimal.Decimal
+	Amount decimal.Decimal
+}
+
+// Truncate - keeps the depth best levels of each side, a non-positive depth keeps them all
+func (b *OrderBook) Truncate(depth int) {
+	if depth <= 0 {
+		return
+	}
+
+	b.Asks = b.Asks[:min(depth, len(b.Asks))]
+	b.Bids = b.Bids[:min(depth, len(b.Bids))]
+}
+
+// Equal - reports whether both books have the same levels, nil books are equal to nil only
+func (b *OrderBook) Equal(other *OrderBook) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+
+	return equalLevels(b.Asks, other.Asks) && equalLevels(b.Bids, other.Bids)
+}
+
+func equalLevels(a, b []OrderBookLevel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].Price.Equal(b[i].Price) || !a[i].Volume.Equal(b[i].Volume) || !a[i].Amount.Equal(b[i].Amount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l OrderBookLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]decimal.Decimal{l.Price, l.Volume, l.Amount})
+}
+
+func (l *OrderBookLevel) UnmarshalJSON(data []byte) error {
+	var values [3]decimal.Decimal
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	l.Price, l.Volume, l.Amount = values[0], values[1], values[2]
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// RateSavedEvent - type of the event emitted for every stored rate
+const RateSavedEvent = "rate.saved"
+
+// OutboxEvent - event written to the outbox together with the change it describes
+type OutboxEvent struct {
+	// ID grows in the order the events of a market are committed.
+	ID     int64  `json:"id"`
+	Type   string `json:"type"`
+	Market string `json:"market"`
+	// Rate is the stored rate, its ID is unset in the write-behind mode.
+	Rate      *ExchangeRate `json:"rate"`
+	CreatedAt time.Time     `json:"created_at"`
+	// Attempts is the number of failed deliveries so far.
+	Attempts int `json:"-"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// OverrideSource - source of the rates served from a manual override
+const OverrideSource = "override"
+
+// RateOverride - fixed rate of a market published manually, served instead of
+// the provider rate while it is active
+type RateOverride struct {
+	Market   string          `json:"market"`
+	AskPrice decimal.Decimal `json:"ask_price"`
+	BidPrice decimal.Decimal `json:"bid_price"`
+	Reason   string          `json:"reason"`
+	// Actor is the identity of the admin who set the override.
+	Actor string `json:"actor"`
+	// ExpiresAt is zero for overrides active until they are cleared.
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Active - reports whether the override is in effect at now
+func (o *RateOverride) Active(now time.Time) bool {
+	return o.ExpiresAt.IsZero() || o.ExpiresAt.After(now)
+}
+
+// ExchangeRate - the override as a rate of its market observed at now
+func (o *RateOverride) ExchangeRate(now time.Time) *ExchangeRate {
+	return &ExchangeRate{
+		Market:    o.Market,
+		AskPrice:  o.AskPrice,
+		BidPrice:  o.BidPrice,
+		TS:        now.Unix(),
+		Source:    OverrideSource,
+		FetchedAt: now,
+	}
+}
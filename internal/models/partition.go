@@ -0,0 +1,22 @@
+package models
+
+import "math"
+
+// RatePartition - partition of the rates table holding the rates with ts in [From, To)
+type RatePartition struct {
+	Name string `json:"name"`
+	// From is math.MinInt64 for the partition without a lower bound.
+	From int64 `json:"from"`
+	// To is math.MaxInt64 for the partition without an upper bound.
+	To int64 `json:"to"`
+}
+
+// Overlaps - reports whether the partition shares any ts with [from, to)
+func (p *RatePartition) Overlaps(from, to int64) bool {
+	return p.From < to && from < p.To
+}
+
+// Unbounded - reports whether the partition has no lower bound
+func (p *RatePartition) Unbounded() bool {
+	return p.From == math.MinInt64
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var bpsDenominator = decimal.NewFromInt(10000)
+
+// PricingRule - markup of a client on top of the raw rates. Rules of a client
+// form amount tiers, the rule with the greatest MinAmount not above the
+// requested amount applies.
+type PricingRule struct {
+	ID       int64  `json:"id"`
+	ClientID string `json:"client_id"`
+	// Market is empty for the rules applied to every market of the client.
+	Market    string          `json:"market"`
+	MinAmount decimal.Decimal `json:"min_amount"`
+	MarkupBps int32           `json:"markup_bps"`
+	// MinFee is the minimum fee of a request in the quote currency, it only
+	// applies when the amount is known.
+	MinFee    decimal.Decimal `json:"min_fee"`
+	Enabled   bool            `json:"enabled"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// MarkUp - widens the raw ask and bid by the markup of the rule. With a
+// positive amount the markup per unit is at least MinFee / amount.
+func (r *PricingRule) MarkUp(ask, bid, amount decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
+	markup := func(price decimal.Decimal) decimal.Decimal {
+		m := price.Mul(decimal.NewFromInt32(r.MarkupBps)).Div(bpsDenominator)
+
+		if amount.IsPositive() {
+			m = decimal.Max(m, r.MinFee.Div(amount))
+		}
+
+		return m
+	}
+
+	return ask.Add(markup(ask)), decimal.Max(bid.Sub(markup(bid)), decimal.Zero)
+}
+
+// QuotedRate - rate of a market as quoted to a client
+type QuotedRate struct {
+	// Rate is the raw rate of the provider.
+	Rate     *ExchangeRate
+	AskPrice decimal.Decimal
+	BidPrice decimal.Decimal
+	// RuleID is the id of the applied pricing rule, zero when none applies.
+	RuleID int64
+	// Overridden is set when Rate comes from a manual override.
+	Overridden bool
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Quote - rate of a market locked for a client until ExpiresAt
+type Quote struct {
+	// ID is the signed quote id.
+	ID       string          `json:"id"`
+	ClientID string          `json:"client_id"`
+	Market   string          `json:"market"`
+	Amount   decimal.Decimal `json:"amount"`
+	// AskPrice and BidPrice are the prices guaranteed to the client.
+	AskPrice      decimal.Decimal `json:"ask_price"`
+	BidPrice      decimal.Decimal `json:"bid_price"`
+	RawAskPrice   decimal.Decimal `json:"raw_ask_price"`
+	RawBidPrice   decimal.Decimal `json:"raw_bid_price"`
+	PricingRuleID int64           `json:"pricing_rule_id"`
+	// RateTS is the exchange timestamp of the quoted rate (Unix, seconds).
+	RateTS    int64     `json:"rate_ts"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// RedeemedAt is zero while the quote is not redeemed.
+	RedeemedAt time.Time `json:"redeemed_at"`
+}
@@ -1,10 +1,60 @@
 package models
 
-import "github.com/shopspring/decimal"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 type ExchangeRate struct {
 	ID       int64           `json:"id"`
+	Market   string          `json:"market"`
 	AskPrice decimal.Decimal `json:"ask_price"`
 	BidPrice decimal.Decimal `json:"bid_price"`
-	TS       int64           `json:"ts"`
+	// TS is the exchange timestamp of the snapshot (Unix, seconds).
+	TS int64 `json:"ts"`
+	// Source is the provider the snapshot was fetched from.
+	Source string `json:"source"`
+	// FetchedAt is the local time the snapshot was received.
+	FetchedAt time.Time `json:"fetched_at"`
+	// Latency is the duration of the upstream request.
+	Latency   time.Duration `json:"latency"`
+	RequestID string        `json:"request_id"`
+	// ValidTo is the ts of the next stored snapshot of the market, the snapshot
+	// is in effect over [TS, ValidTo). Zero while it is the latest one and for
+	// the snapshots served from the aggregates.
+	ValidTo int64 `json:"valid_to"`
+	// Book is the order book the snapshot was taken from, set when the books
+	// are stored. Not kept in memory beyond the save.
+	Book *OrderBook `json:"-"`
+}
+
+// RateCursor - position of a rate in the (market, ts, id) keyset
+type RateCursor struct {
+	TS int64 `json:"ts"`
+	ID int64 `json:"id"`
+}
+
+// RateFilter - filter of the stored rate history
+type RateFilter struct {
+	Market string
+	// From is inclusive, 0 means no lower bound.
+	From int64
+	// To is exclusive, 0 means no upper bound.
+	To    int64
+	Desc  bool
+	Limit int
+	// After continues the listing right after the given rate.
+	After *RateCursor
+	// Resolution of the listed history, empty picks it by From.
+	Resolution Resolution
+}
+
+// MarketRateResult - outcome of fetching the rate of a single market in a batch
+type MarketRateResult struct {
+	Market string
+	Quote  *QuotedRate
+	// Cached is set when the rate was served from the recent fetches.
+	Cached bool
+	Err    error
 }
@@ -0,0 +1,25 @@
+package models
+
+// Resolution - resolution of the stored rate history
+type Resolution string
+
+const (
+	// ResolutionRaw - every stored snapshot
+	ResolutionRaw Resolution = "raw"
+	// ResolutionMinute - the last snapshot of every minute
+	ResolutionMinute Resolution = "1m"
+	// ResolutionHour - the last snapshot of every hour
+	ResolutionHour Resolution = "1h"
+)
+
+// Step - bucket width of the resolution in seconds, 0 for the raw history
+func (r Resolution) Step() int64 {
+	switch r {
+	case ResolutionMinute:
+		return 60
+	case ResolutionHour:
+		return 3600
+	default:
+		return 0
+	}
+}
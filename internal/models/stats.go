@@ -0,0 +1,37 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+const (
+	StatsSourceMemory  = "memory"
+	StatsSourceStorage = "storage"
+)
+
+// RateStats - statistics of a market over a time window
+type RateStats struct {
+	Market  string
+	From    int64
+	To      int64
+	Samples int
+	Source  string
+	// Resolution of the stored history the statistics are computed on.
+	Resolution Resolution
+	Bid        PriceStats
+	Ask        PriceStats
+	Mid        PriceStats
+}
+
+// PriceStats - statistics of a single price series
+type PriceStats struct {
+	TWAP        decimal.Decimal
+	Min         decimal.Decimal
+	Max         decimal.Decimal
+	Volatility  float64
+	Percentiles []PercentileValue
+}
+
+// PercentileValue - value of a percentile of a price series
+type PercentileValue struct {
+	Percentile float64
+	Value      decimal.Decimal
+}
@@ -0,0 +1,98 @@
+package exchangerate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// BatchGetExchangeRates returns the rates of the distinct markets in request
+// order, quoted for the client as QuoteExchangeRate does without an amount.
+// Markets fetched within their polling interval are served from the recent
+// ticks, the others are fetched concurrently by a bounded pool of workers. A
+// failing market only fails its own result.
+func (m *Module) BatchGetExchangeRates(ctx context.Context, clientID string, markets []string) []models.MarketRateResult {
+	results := make([]models.MarketRateResult, 0, len(markets))
+	seen := make(map[string]struct{}, len(markets))
+
+	for _, market := range markets {
+		if _, ok := seen[market]; ok {
+			continue
+		}
+
+		seen[market] = struct{}{}
+		results = append(results, models.MarketRateResult{Market: market})
+	}
+
+	jobs := make(chan *models.MarketRateResult)
+
+	var wg sync.WaitGroup
+
+	for range max(1, min(m.cfg.Batch.Workers, len(results))) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for result := range jobs {
+				result.Quote, result.Cached, result.Err = m.quoteBatchExchangeRate(ctx, result.Market, clientID)
+			}
+		}()
+	}
+
+	for i := range results {
+		jobs <- &results[i]
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// quoteBatchExchangeRate quotes the rate of the market for the client with no
+// amount. An active override comes first, then the rate fetched within the polling
+// interval; cached reports the latter.
+func (m *Module) quoteBatchExchangeRate(ctx context.Context, market, clientID string) (_ *models.QuotedRate, cached bool, err error) {
+	rate, err := m.overriddenExchangeRate(ctx, market)
+	if err != nil {
+		return nil, false, err
+	}
+
+	overridden := rate != nil
+
+	if !overridden {
+		if rate, cached = m.cachedExchangeRate(ctx, market); !cached {
+			if rate, err = m.GetExchangeRate(ctx, market); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	quoted, err := m.priceExchangeRate(ctx, rate, overridden, clientID, decimal.Zero)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return quoted, cached, nil
+}
+
+// cachedExchangeRate returns the last fetched rate of the market if it was fetched
+// within the polling interval of the market and the market is still enabled.
+func (m *Module) cachedExchangeRate(ctx context.Context, market string) (*models.ExchangeRate, bool) {
+	settings, err := m.fetchableMarket(ctx, market)
+	if err != nil || settings.PollingInterval <= 0 {
+		return nil, false
+	}
+
+	rate, ok := m.recentTicks.lastFetched(market)
+	if !ok || time.Since(rate.FetchedAt) >= settings.PollingInterval {
+		return nil, false
+	}
+
+	return rate, true
+}
@@ -2,15 +2,24 @@ package exchangerate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/KVSH-user/ExchangeRateService/internal/adapters/garantex"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
 	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
 )
 
 type RateStorage interface {
 	SaveExchangeRate(ctx context.Context, rate *models.ExchangeRate) error
+	GetExchangeRateAt(ctx context.Context, market string, resolution models.Resolution, ts, notBefore int64) (*models.ExchangeRate, error)
+	GetLatestExchangeRate(ctx context.Context, market string) (*models.ExchangeRate, error)
+	ListExchangeRates(ctx context.Context, filter models.RateFilter) ([]*models.ExchangeRate, error)
+	GetOrderBookAt(ctx context.Context, market string, ts, notBefore int64) (*models.OrderBook, error)
+	Ping(ctx context.Context) error
 }
 
 type GarantexClient interface {
@@ -18,20 +27,56 @@ type GarantexClient interface {
 }
 
 type Module struct {
-	log            *slog.Logger
-	rateStorage    RateStorage
-	garantexClient GarantexClient
+	log             *slog.Logger
+	cfg             *config.Config
+	rateStorage     RateStorage
+	garantexClient  GarantexClient
+	marketStorage   MarketStorage
+	overrideStorage OverrideStorage
+	pricingRules    PricingRules
+	recentTicks     *recentTicks
+	feed            *rateFeed
+	markets         *marketRegistry
+	overrides       *overrideCache
 }
 
-func New(log *slog.Logger, rateStorage RateStorage, garantexClient GarantexClient) *Module {
-	return &Module{
-		log:            log,
-		rateStorage:    rateStorage,
-		garantexClient: garantexClient,
+func New(
+	log *slog.Logger,
+	cfg *config.Config,
+	rateStorage RateStorage,
+	marketStorage MarketStorage,
+	overrideStorage OverrideStorage,
+	garantexClient GarantexClient,
+	pricingRules PricingRules,
+) (*Module, error) {
+	markets, err := newMarketRegistry(marketStorage, &cfg.Markets)
+	if err != nil {
+		return nil, fmt.Errorf("could not init market registry: %w", err)
 	}
+
+	return &Module{
+		log:             log,
+		cfg:             cfg,
+		rateStorage:     rateStorage,
+		garantexClient:  garantexClient,
+		marketStorage:   marketStorage,
+		overrideStorage: overrideStorage,
+		pricingRules:    pricingRules,
+		recentTicks:     newRecentTicks(cfg.Stats.RecentWindow, cfg.Stats.RecentMaxTicks),
+		feed:            newRateFeed(cfg.RateFanout.MaxSubscribers, cfg.RateFanout.SubscriberBuffer),
+		markets:         markets,
+		overrides:       newOverrideCache(overrideStorage, cfg.Overrides.RefreshInterval),
+	}, nil
 }
 
 func (m *Module) GetExchangeRate(ctx context.Context, market string) (*models.ExchangeRate, error) {
+	settings, err := m.fetchableMarket(ctx, market)
+	if err != nil {
+		return nil, fmt.Errorf("could not get exchange rate: %w", err)
+	}
+
+	start := time.Now()
+
 	resp, err := m.garantexClient.GetExchangeRate(ctx, market)
 	if err != nil {
 		m.log.ErrorContext(ctx, "failed to fetch exchange rate", "error", err)
@@ -46,12 +91,214 @@ func (m *Module) GetExchangeRate(ctx context.Context, market string) (*models.Ex
 		return nil, fmt.Errorf("could not convert exchange rate to model: %w", err)
 	}
 
+	if m.cfg.OrderBook.Enabled {
+		rate.Book, err = resp.ToOrderBookModel()
+		if err != nil {
+			m.log.ErrorContext(ctx, "failed to convert order book to model", "error", err)
+
+			return nil, fmt.Errorf("could not convert order book to model: %w", err)
+		}
+
+		rate.Book.Market = market
+		rate.Book.Truncate(m.cfg.OrderBook.Depth)
+	}
+
+	rate.Market = market
+	rate.AskPrice = settings.Round(rate.AskPrice)
+	rate.BidPrice = settings.Round(rate.BidPrice)
+	rate.FetchedAt = time.Now()
+	rate.Latency = rate.FetchedAt.Sub(start)
+	rate.RequestID = utils.RequestIDFromContext(ctx)
+
+	// Only the on-change markets look at the last stored rate, the others store
+	// every fetch without a read.
+	if settings.StoreMode == models.StoreOnChange && m.unchanged(ctx, &settings, rate) {
+		m.recentTicks.fetch(rate)
+
+		return rate, nil
+	}
+
 	err = m.rateStorage.SaveExchangeRate(ctx, rate)
-	if err != nil {
+	switch {
+	case errors.Is(err, models.ErrWriteQueueFull):
+		// The rate is still served, only its history entry is lost.
+		m.log.WarnContext(ctx, "exchange rate dropped from history", "market", market, "error", err)
+	case errors.Is(err, models.ErrStorageUnavailable):
+		// Degraded mode, there is no history to keep the rate in.
+		m.log.DebugContext(ctx, "exchange rate not stored", "market", market, "error", err)
+	case err != nil:
 		m.log.ErrorContext(ctx, "failed to save exchange rate", "error", err)
 
 		return nil, fmt.Errorf("could not save exchange rate: %w", err)
 	}
 
+	m.acceptRate(rate)
+
 	return rate, nil
 }
+
+// ReceiveRate records a rate stored by any instance, as passed on by the rate
+// fan-out, unless it is known already.
+func (m *Module) ReceiveRate(rate *models.ExchangeRate) {
+	m.acceptRate(rate)
+}
+
+// SubscribeRates returns the channel of the rates of the markets as they are
+// stored, of all the markets when none are given. With the rate fan-out these
+// include the rates stored by the other instances, and after a reconnection the
+// missed ones, possibly out of ts order. The channel is closed once ctx is done or
+// the subscriber falls behind.
+func (m *Module) SubscribeRates(ctx context.Context, markets []string) (<-chan *models.ExchangeRate, error) {
+	for _, market := range markets {
+		if _, err := m.fetchableMarket(ctx, market); err != nil {
+			return nil, fmt.Errorf("could not subscribe to rates: %w", err)
+		}
+	}
+
+	return m.feed.subscribe(ctx, markets)
+}
+
+// acceptRate keeps a newly stored rate among the recent ticks and passes it to the
+// subscribers. A rate stored by this instance comes back over the fan-out and is
+// only passed on once.
+func (m *Module) acceptRate(rate *models.ExchangeRate) {
+	if m.recentTicks.add(rate) {
+		m.feed.publish(rate)
+	}
+}
+
+// PingStorage reports whether the storage is available, nil when it is.
+func (m *Module) PingStorage(ctx context.Context) error {
+	return m.rateStorage.Ping(ctx)
+}
+
+// unchanged reports whether the fetched rate of an on-change market is the same as
+// the last stored one. With the order books stored, a book that moved below the top
+// counts as a change too, so that the stored book of a snapshot is the one it had.
+func (m *Module) unchanged(ctx context.Context, settings *models.Market, rate *models.ExchangeRate) bool {
+	if !settings.Unchanged(m.lastStoredRate(ctx, rate.Market), rate) {
+		return false
+	}
+
+	// An unknown last book, e.g. after a restart, is taken as a change.
+	return !m.cfg.OrderBook.Enabled || rate.Book.Equal(m.recentTicks.latestBook(rate.Market))
+}
+
+// lastStoredRate returns the latest snapshot of the market stored by this instance
+// or, with the rate fan-out, by any instance. The stored history is only read when
+// there is no recent tick of the market, e.g. after a restart. Nil when unknown.
+func (m *Module) lastStoredRate(ctx context.Context, market string) *models.ExchangeRate {
+	if recent, ok := m.recentTicks.latest(market); ok {
+		return recent
+	}
+
+	stored, err := m.rateStorage.GetLatestExchangeRate(ctx, market)
+	if err != nil {
+		if !errors.Is(err, models.ErrRateNotFound) && !errors.Is(err, models.ErrStorageUnavailable) {
+			m.log.WarnContext(ctx, "failed to get latest stored rate", "market", market, "error", err)
+		}
+
+		return nil
+	}
+
+	return stored
+}
+
+// GetExchangeRateAt returns the stored rate of the market that was in effect at ts.
+// Snapshots older than maxStaleness relative to ts are not considered, a zero
+// maxStaleness falls back to the staleness limit of the market and then to the
+// configured default.
+func (m *Module) GetExchangeRateAt(ctx context.Context, market string, ts int64, maxStaleness time.Duration) (*models.ExchangeRate, error) {
+	notBefore := m.notBefore(ctx, market, ts, maxStaleness)
+
+	rate, err := m.rateStorage.GetExchangeRateAt(ctx, market, m.HistoryResolution(market, notBefore), ts, notBefore)
+	if err != nil {
+		return nil, fmt.Errorf("could not get exchange rate at %d: %w", ts, err)
+	}
+
+	return rate, nil
+}
+
+// notBefore returns the earliest ts of a snapshot that may still be in effect
+// at ts, see GetExchangeRateAt.
+func (m *Module) notBefore(ctx context.Context, market string, ts int64, maxStaleness time.Duration) int64 {
+	if maxStaleness <= 0 {
+		maxStaleness = m.markets.settings(ctx, market).StalenessLimit
+	}
+
+	if maxStaleness <= 0 {
+		maxStaleness = m.cfg.History.MaxStaleness
+	}
+
+	return ts - int64(maxStaleness/time.Second)
+}
+
+// ListExchangeRates returns a page of the stored rate history together with the
+// cursor of the next page, which is nil on the last page. The page size is
+// bounded by the history settings, an empty resolution is picked by the start of
+// the range.
+func (m *Module) ListExchangeRates(ctx context.Context, filter models.RateFilter) ([]*models.ExchangeRate, *models.RateCursor, error) {
+	if filter.Resolution == "" {
+		filter.Resolution = m.HistoryResolution(filter.Market, filter.From)
+	}
+
+	limit := filter.Limit
+
+	switch {
+	case limit <= 0:
+		limit = m.cfg.History.DefaultPageSize
+	case limit > m.cfg.History.MaxPageSize:
+		limit = m.cfg.History.MaxPageSize
+	}
+
+	// One extra row tells whether there is a next page.
+	filter.Limit = limit + 1
+
+	rates, err := m.rateStorage.ListExchangeRates(ctx, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not list exchange rates: %w", err)
+	}
+
+	if len(rates) <= limit {
+		return rates, nil, nil
+	}
+
+	rates = rates[:limit]
+	last := rates[limit-1]
+
+	return rates, &models.RateCursor{TS: last.TS, ID: last.ID}, nil
+}
+
+// HistoryResolution returns the finest resolution of the stored history of the
+// market that still covers the history from ts on. Raw rates are kept for the
+// retention of the market, the aggregates for the rollup retentions.
+func (m *Module) HistoryResolution(market string, ts int64) models.Resolution {
+	if !m.cfg.Rollup.Enabled {
+		return models.ResolutionRaw
+	}
+
+	now := time.Now()
+
+	covers := func(retention time.Duration) bool {
+		return retention == 0 || ts >= now.Add(-retention).Unix()
+	}
+
+	raw := m.cfg.Retention.Default
+
+	for _, r := range m.cfg.Retention.Markets {
+		if r.Market == market {
+			raw = r.Retention
+
+			break
+		}
+	}
+
+	switch {
+	case covers(raw):
+		return models.ResolutionRaw
+	case covers(m.cfg.Rollup.MinuteRetention):
+		return models.ResolutionMinute
+	default:
+		return models.ResolutionHour
+	}
+}
@@ -0,0 +1,96 @@
+package exchangerate
+
+import (
+	"context"
+	"sync"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// rateFeed passes the newly stored rates to the subscribers. A subscriber that
+// does not keep up is dropped rather than holding the others back.
+type rateFeed struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	max         int
+	buffer      int
+}
+
+// subscriber - rates of the markets, all of them when markets is nil
+type subscriber struct {
+	markets map[string]struct{}
+	rates   chan *models.ExchangeRate
+}
+
+func newRateFeed(maxSubscribers, buffer int) *rateFeed {
+	return &rateFeed{
+		subscribers: make(map[*subscriber]struct{}),
+		max:         maxSubscribers,
+		buffer:      buffer,
+	}
+}
+
+// subscribe returns the channel of the rates of the markets, closed once ctx is
+// done or the subscriber falls behind by more than the buffer.
+func (f *rateFeed) subscribe(ctx context.Context, markets []string) (<-chan *models.ExchangeRate, error) {
+	sub := &subscriber{
+		rates: make(chan *models.ExchangeRate, f.buffer),
+	}
+
+	if len(markets) > 0 {
+		sub.markets = make(map[string]struct{}, len(markets))
+
+		for _, market := range markets {
+			sub.markets[market] = struct{}{}
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.subscribers) >= f.max {
+		return nil, models.ErrTooManySubscribers
+	}
+
+	f.subscribers[sub] = struct{}{}
+
+	context.AfterFunc(ctx, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.drop(sub)
+	})
+
+	return sub.rates, nil
+}
+
+// publish passes rate to the subscribers of its market.
+func (f *rateFeed) publish(rate *models.ExchangeRate) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for sub := range f.subscribers {
+		if sub.markets != nil {
+			if _, ok := sub.markets[rate.Market]; !ok {
+				continue
+			}
+		}
+
+		select {
+		case sub.rates <- rate:
+		default:
+			f.drop(sub)
+		}
+	}
+}
+
+// drop closes the channel of the subscriber, unless it is dropped already. Must be
+// called with mu held.
+func (f *rateFeed) drop(sub *subscriber) {
+	if _, ok := f.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(f.subscribers, sub)
+	close(sub.rates)
+}
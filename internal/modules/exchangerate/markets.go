@@ -0,0 +1,256 @@
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/adapters/garantex"
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+type MarketStorage interface {
+	ListMarkets(ctx context.Context) ([]*models.Market, error)
+	GetMarket(ctx context.Context, symbol string) (*models.Market, error)
+	UpsertMarket(ctx context.Context, market *models.Market) error
+	SetMarketEnabled(ctx context.Context, symbol string, enabled bool) (*models.Market, error)
+	SeedMarkets(ctx context.Context, markets []*models.Market) error
+}
+
+// marketRegistry caches the registered markets for refreshInterval, so that the
+// registry can be consulted on every fetch without a database round trip.
+type marketRegistry struct {
+	storage           MarketStorage
+	defaults          models.Market
+	allowUnregistered bool
+	refreshInterval   time.Duration
+
+	mu       sync.RWMutex
+	markets  map[string]models.Market
+	loadedAt time.Time
+}
+
+func newMarketRegistry(storage MarketStorage, cfg *config.Markets) (*marketRegistry, error) {
+	defaults := models.Market{
+		Symbol:            "default",
+		Provider:          garantex.SourceName,
+		Precision:         cfg.DefaultPrecision,
+		Rounding:          models.RoundingMode(cfg.DefaultRounding),
+		Enabled:           true,
+		StoreMode:         models.StoreMode(cfg.DefaultStoreMode),
+		HeartbeatInterval: cfg.DefaultHeartbeatInterval,
+	}
+
+	if err := defaults.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &marketRegistry{
+		storage:           storage,
+		defaults:          defaults,
+		allowUnregistered: cfg.AllowUnregistered,
+		refreshInterval:   cfg.RefreshInterval,
+	}, nil
+}
+
+// lookup returns the settings of the market. Unregistered markets get the
+// default settings, registered reports whether the market is in the registry.
+func (r *marketRegistry) lookup(ctx context.Context, symbol string) (market models.Market, registered bool, err error) {
+	markets, err := r.load(ctx)
+	if err != nil {
+		return models.Market{}, false, err
+	}
+
+	if market, ok := markets[symbol]; ok {
+		return market, true, nil
+	}
+
+	market = r.defaults
+	market.Symbol = symbol
+
+	return market, false, nil
+}
+
+// settings returns the settings of the market, falling back to the defaults
+// when the registry is unavailable.
+func (r *marketRegistry) settings(ctx context.Context, symbol string) models.Market {
+	market, _, err := r.lookup(ctx, symbol)
+	if err != nil {
+		market = r.defaults
+		market.Symbol = symbol
+	}
+
+	return market
+}
+
+// load returns the cached markets, reloading them once refreshInterval has
+// passed. A stale copy is served while the storage is failing.
+func (r *marketRegistry) load(ctx context.Context) (map[string]models.Market, error) {
+	r.mu.RLock()
+	markets, loadedAt := r.markets, r.loadedAt
+	r.mu.RUnlock()
+
+	if markets != nil && time.Since(loadedAt) < r.refreshInterval {
+		return markets, nil
+	}
+
+	list, err := r.storage.ListMarkets(ctx)
+	if err != nil {
+		if markets != nil {
+			return markets, nil
+		}
+
+		return nil, fmt.Errorf("could not load market registry: %w", err)
+	}
+
+	markets = make(map[string]models.Market, len(list))
+	for _, market := range list {
+		markets[market.Symbol] = *market
+	}
+
+	r.mu.Lock()
+	r.markets, r.loadedAt = markets, time.Now()
+	r.mu.Unlock()
+
+	return markets, nil
+}
+
+// invalidate makes the next lookup reload the registry.
+func (r *marketRegistry) invalidate() {
+	r.mu.Lock()
+	r.loadedAt = time.Time{}
+	r.mu.Unlock()
+}
+
+// fetchableMarket returns the settings of the market if rates of it may be
+// fetched right now.
+func (m *Module) fetchableMarket(ctx context.Context, symbol string) (models.Market, error) {
+	market, registered, err := m.markets.lookup(ctx, symbol)
+	if err != nil {
+		return models.Market{}, err
+	}
+
+	switch {
+	case !registered && !m.markets.allowUnregistered:
+		return models.Market{}, fmt.Errorf("%s: %w", symbol, models.ErrMarketNotFound)
+	case !market.Enabled:
+		return models.Market{}, fmt.Errorf("%s: %w", symbol, models.ErrMarketDisabled)
+	default:
+		return market, nil
+	}
+}
+
+// SeedMarkets registers the markets listed in the config that are not
+// registered yet.
+func (m *Module) SeedMarkets(ctx context.Context) error {
+	markets := make([]*models.Market, 0, len(m.cfg.Markets.List))
+
+	for _, seed := range m.cfg.Markets.List {
+		precision := m.markets.defaults.Precision
+		if seed.Precision != nil {
+			precision = *seed.Precision
+		}
+
+		market := &models.Market{
+			Symbol:            seed.Symbol,
+			BaseCurrency:      seed.BaseCurrency,
+			QuoteCurrency:     seed.QuoteCurrency,
+			Provider:          seed.Provider,
+			Precision:         precision,
+			Rounding:          models.RoundingMode(seed.Rounding),
+			PollingInterval:   seed.PollingInterval,
+			StalenessLimit:    seed.StalenessLimit,
+			Enabled:           seed.Enabled == nil || *seed.Enabled,
+			StoreMode:         models.StoreMode(seed.StoreMode),
+			HeartbeatInterval: seed.HeartbeatInterval,
+		}
+
+		if err := m.validateMarket(market); err != nil {
+			return err
+		}
+
+		markets = append(markets, market)
+	}
+
+	if err := m.marketStorage.SeedMarkets(ctx, markets); err != nil {
+		return fmt.Errorf("could not seed markets: %w", err)
+	}
+
+	m.markets.invalidate()
+
+	return nil
+}
+
+func (m *Module) ListMarkets(ctx context.Context) ([]*models.Market, error) {
+	markets, err := m.marketStorage.ListMarkets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list markets: %w", err)
+	}
+
+	return markets, nil
+}
+
+func (m *Module) GetMarket(ctx context.Context, symbol string) (*models.Market, error) {
+	market, err := m.marketStorage.GetMarket(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("could not get market: %w", err)
+	}
+
+	return market, nil
+}
+
+func (m *Module) UpsertMarket(ctx context.Context, market *models.Market) error {
+	if err := m.validateMarket(market); err != nil {
+		return err
+	}
+
+	if err := m.marketStorage.UpsertMarket(ctx, market); err != nil {
+		return fmt.Errorf("could not upsert market: %w", err)
+	}
+
+	m.markets.invalidate()
+
+	m.log.InfoContext(ctx, "market upserted", "market", market.Symbol, "enabled", market.Enabled)
+
+	return nil
+}
+
+func (m *Module) SetMarketEnabled(ctx context.Context, symbol string, enabled bool) (*models.Market, error) {
+	market, err := m.marketStorage.SetMarketEnabled(ctx, symbol, enabled)
+	if err != nil {
+		return nil, fmt.Errorf("could not set market enabled: %w", err)
+	}
+
+	m.markets.invalidate()
+
+	m.log.InfoContext(ctx, "market enabled changed", "market", symbol, "enabled", enabled)
+
+	return market, nil
+}
+
+// validateMarket fills in the defaults of the market and checks its settings.
+func (m *Module) validateMarket(market *models.Market) error {
+	if market.Provider == "" {
+		market.Provider = garantex.SourceName
+	}
+
+	if market.Rounding == "" {
+		market.Rounding = m.markets.defaults.Rounding
+	}
+
+	if market.StoreMode == "" {
+		market.StoreMode = m.markets.defaults.StoreMode
+	}
+
+	if market.StoreMode == models.StoreOnChange && market.HeartbeatInterval == 0 {
+		market.HeartbeatInterval = m.markets.defaults.HeartbeatInterval
+	}
+
+	if market.Provider != garantex.SourceName {
+		return fmt.Errorf("%w: unsupported provider %q of %s", models.ErrInvalidMarket, market.Provider, market.Symbol)
+	}
+
+	return market.Validate()
+}
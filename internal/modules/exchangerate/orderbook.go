@@ -0,0 +1,21 @@
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// GetOrderBookAt returns the stored order book of the market snapshot that was
+// in effect at ts. The staleness is bounded the same way as in GetExchangeRateAt.
+// Books are only kept with the raw history.
+func (m *Module) GetOrderBookAt(ctx context.Context, market string, ts int64, maxStaleness time.Duration) (*models.OrderBook, error) {
+	book, err := m.rateStorage.GetOrderBookAt(ctx, market, ts, m.notBefore(ctx, market, ts, maxStaleness))
+	if err != nil {
+		return nil, fmt.Errorf("could not get order book at %d: %w", ts, err)
+	}
+
+	return book, nil
+}
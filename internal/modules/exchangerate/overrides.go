@@ -0,0 +1,165 @@
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+)
+
+type OverrideStorage interface {
+	ListRateOverrides(ctx context.Context) ([]*models.RateOverride, error)
+	SetRateOverride(ctx context.Context, override *models.RateOverride) error
+	ClearRateOverride(ctx context.Context, market, actor, reason string) error
+}
+
+// overrideCache caches the active rate overrides for refreshInterval, so that
+// they can be consulted on every rate request.
+type overrideCache struct {
+	storage         OverrideStorage
+	refreshInterval time.Duration
+
+	mu        sync.RWMutex
+	overrides map[string]models.RateOverride
+	loadedAt  time.Time
+}
+
+func newOverrideCache(storage OverrideStorage, refreshInterval time.Duration) *overrideCache {
+	return &overrideCache{
+		storage:         storage,
+		refreshInterval: refreshInterval,
+	}
+}
+
+// active returns the override of the market if it is in effect now.
+func (c *overrideCache) active(ctx context.Context, market string) (*models.RateOverride, error) {
+	overrides, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	override, ok := overrides[market]
+	if !ok || !override.Active(time.Now()) {
+		return nil, nil
+	}
+
+	return &override, nil
+}
+
+// load returns the cached overrides, reloading them once refreshInterval has
+// passed. A stale copy is served while the storage is failing.
+func (c *overrideCache) load(ctx context.Context) (map[string]models.RateOverride, error) {
+	c.mu.RLock()
+	overrides, loadedAt := c.overrides, c.loadedAt
+	c.mu.RUnlock()
+
+	if overrides != nil && time.Since(loadedAt) < c.refreshInterval {
+		return overrides, nil
+	}
+
+	list, err := c.storage.ListRateOverrides(ctx)
+	if err != nil {
+		if overrides != nil {
+			return overrides, nil
+		}
+
+		return nil, fmt.Errorf("could not load rate overrides: %w", err)
+	}
+
+	overrides = make(map[string]models.RateOverride, len(list))
+	for _, override := range list {
+		overrides[override.Market] = *override
+	}
+
+	c.mu.Lock()
+	c.overrides, c.loadedAt = overrides, time.Now()
+	c.mu.Unlock()
+
+	return overrides, nil
+}
+
+// invalidate makes the next lookup reload the overrides.
+func (c *overrideCache) invalidate() {
+	c.mu.Lock()
+	c.loadedAt = time.Time{}
+	c.mu.Unlock()
+}
+
+// overriddenExchangeRate returns the rate of the active override of the market,
+// nil when there is none.
+func (m *Module) overriddenExchangeRate(ctx context.Context, market string) (*models.ExchangeRate, error) {
+	override, err := m.overrides.active(ctx, market)
+	if err != nil || override == nil {
+		return nil, err
+	}
+
+	if _, err = m.fetchableMarket(ctx, market); err != nil {
+		return nil, fmt.Errorf("could not get exchange rate: %w", err)
+	}
+
+	rate := override.ExchangeRate(time.Now())
+	rate.RequestID = utils.RequestIDFromContext(ctx)
+
+	return rate, nil
+}
+
+func (m *Module) ListRateOverrides(ctx context.Context) ([]*models.RateOverride, error) {
+	overrides, err := m.overrideStorage.ListRateOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list rate overrides: %w", err)
+	}
+
+	return overrides, nil
+}
+
+func (m *Module) SetRateOverride(ctx context.Context, override *models.RateOverride) error {
+	switch {
+	case override.Actor == "":
+		return fmt.Errorf("%w: actor is required", models.ErrInvalidOverride)
+	case !override.AskPrice.IsPositive() || !override.BidPrice.IsPositive():
+		return fmt.Errorf("%w: prices must be positive", models.ErrInvalidOverride)
+	case override.BidPrice.GreaterThan(override.AskPrice):
+		return fmt.Errorf("%w: bid price exceeds ask price", models.ErrInvalidOverride)
+	case !override.ExpiresAt.IsZero() && !override.ExpiresAt.After(time.Now()):
+		return fmt.Errorf("%w: expiry is in the past", models.ErrInvalidOverride)
+	}
+
+	settings := m.markets.settings(ctx, override.Market)
+
+	override.AskPrice = settings.Round(override.AskPrice)
+	override.BidPrice = settings.Round(override.BidPrice)
+
+	if err := m.overrideStorage.SetRateOverride(ctx, override); err != nil {
+		return fmt.Errorf("could not set rate override: %w", err)
+	}
+
+	m.overrides.invalidate()
+
+	m.log.WarnContext(ctx, "rate override set",
+		"market", override.Market,
+		"actor", override.Actor,
+		"reason", override.Reason,
+		"expires_at", override.ExpiresAt,
+	)
+
+	return nil
+}
+
+func (m *Module) ClearRateOverride(ctx context.Context, market, actor, reason string) error {
+	if actor == "" {
+		return fmt.Errorf("%w: actor is required", models.ErrInvalidOverride)
+	}
+
+	if err := m.overrideStorage.ClearRateOverride(ctx, market, actor, reason); err != nil {
+		return fmt.Errorf("could not clear rate override: %w", err)
+	}
+
+	m.overrides.invalidate()
+
+	m.log.WarnContext(ctx, "rate override cleared", "market", market, "actor", actor, "reason", reason)
+
+	return nil
+}
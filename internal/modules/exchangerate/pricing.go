@@ -0,0 +1,73 @@
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+type PricingRules interface {
+	Rule(ctx context.Context, clientID, market string, amount decimal.Decimal) (*models.PricingRule, error)
+}
+
+// QuoteExchangeRate fetches the rate of the market, or takes it from an active
+// override, and applies the pricing rule of the client for amount to it.
+// Clients without rules get the raw rate.
+func (m *Module) QuoteExchangeRate(ctx context.Context, market, clientID string, amount decimal.Decimal) (*models.QuotedRate, error) {
+	rate, err := m.overriddenExchangeRate(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+
+	overridden := rate != nil
+
+	if !overridden {
+		rate, err = m.GetExchangeRate(ctx, market)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m.priceExchangeRate(ctx, rate, overridden, clientID, amount)
+}
+
+// priceExchangeRate applies the pricing rule of the client for amount to the rate
+// of its market.
+func (m *Module) priceExchangeRate(
+	ctx context.Context,
+	rate *models.ExchangeRate,
+	overridden bool,
+	clientID string,
+	amount decimal.Decimal,
+) (*models.QuotedRate, error) {
+	market := rate.Market
+
+	quoted := &models.QuotedRate{
+		Rate:       rate,
+		AskPrice:   rate.AskPrice,
+		BidPrice:   rate.BidPrice,
+		Overridden: overridden,
+	}
+
+	rule, err := m.pricingRules.Rule(ctx, clientID, market, amount)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pricing rule: %w", err)
+	}
+
+	if rule == nil {
+		return quoted, nil
+	}
+
+	settings := m.markets.settings(ctx, market)
+
+	ask, bid := rule.MarkUp(rate.AskPrice, rate.BidPrice, amount)
+
+	quoted.AskPrice = settings.Round(ask)
+	quoted.BidPrice = settings.Round(bid)
+	quoted.RuleID = rule.ID
+
+	return quoted, nil
+}
@@ -0,0 +1,221 @@
+package exchangerate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+var two = decimal.NewFromInt(2)
+
+// GetRateStats computes statistics of the market over [from, to). Windows
+// covered by the recent ticks of the instance are served from memory, others
+// from the stored history.
+func (m *Module) GetRateStats(ctx context.Context, market string, from, to int64, percentiles []float64) (*models.RateStats, error) {
+	if to == 0 {
+		to = time.Now().Unix()
+	}
+
+	source := models.StatsSourceMemory
+	resolution := models.ResolutionRaw
+
+	prior, ticks, ok := m.recentTicks.between(market, from, to)
+	if !ok {
+		var err error
+
+		source = models.StatsSourceStorage
+		resolution = m.HistoryResolution(market, from)
+
+		prior, ticks, err = m.storedTicks(ctx, market, resolution, from, to)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if prior != nil {
+		ticks = append([]models.ExchangeRate{*prior}, ticks...)
+	}
+
+	if len(ticks) == 0 {
+		return nil, fmt.Errorf("could not compute stats of %s: %w", market, models.ErrRateNotFound)
+	}
+
+	settings := m.markets.settings(ctx, market)
+
+	return &models.RateStats{
+		Market:     market,
+		From:       from,
+		To:         to,
+		Samples:    len(ticks),
+		Source:     source,
+		Resolution: resolution,
+		Bid: priceStats(ticks, from, to, percentiles, &settings, func(r *models.ExchangeRate) decimal.Decimal {
+			return r.BidPrice
+		}),
+		Ask: priceStats(ticks, from, to, percentiles, &settings, func(r *models.ExchangeRate) decimal.Decimal {
+			return r.AskPrice
+		}),
+		Mid: priceStats(ticks, from, to, percentiles, &settings, func(r *models.ExchangeRate) decimal.Decimal {
+			// An empty side of the book is stored as 0, the mid of such a tick is skipped.
+			if !r.BidPrice.IsPositive() || !r.AskPrice.IsPositive() {
+				return decimal.Zero
+			}
+
+			return r.BidPrice.Add(r.AskPrice).Div(two)
+		}),
+	}, nil
+}
+
+// storedTicks loads the stored rates of the market in [from, to) at the
+// resolution and the rate in effect at from.
+func (m *Module) storedTicks(
+	ctx context.Context,
+	market string,
+	resolution models.Resolution,
+	from, to int64,
+) (*models.ExchangeRate, []models.ExchangeRate, error) {
+	prior, err := m.GetExchangeRateAt(ctx, market, from-1, 0)
+	if err != nil && !errors.Is(err, models.ErrRateNotFound) {
+		return nil, nil, err
+	}
+
+	filter := models.RateFilter{
+		Market:     market,
+		From:       from,
+		To:         to,
+		Limit:      m.cfg.History.MaxPageSize,
+		Resolution: resolution,
+	}
+
+	var ticks []models.ExchangeRate
+
+	for {
+		page, next, err := m.ListExchangeRates(ctx, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, rate := range page {
+			ticks = append(ticks, *rate)
+		}
+
+		if len(ticks) > m.cfg.Stats.MaxSamples {
+			return nil, nil, models.ErrStatsWindowTooLarge
+		}
+
+		if next == nil {
+			return prior, ticks, nil
+		}
+
+		filter.After = next
+	}
+}
+
+// priceStats computes the statistics of the price series picked from ticks.
+// The first tick may precede from, it is then treated as the price in effect at
+// the window start. Non-positive prices (an empty side of the book) are skipped.
+// Derived prices are rounded the same way as the rates of the market.
+func priceStats(
+	ticks []models.ExchangeRate,
+	from, to int64,
+	percentiles []float64,
+	market *models.Market,
+	price func(r *models.ExchangeRate) decimal.Decimal,
+) models.PriceStats {
+	type point struct {
+		ts    int64
+		price decimal.Decimal
+	}
+
+	points := make([]point, 0, len(ticks))
+
+	for i := range ticks {
+		p := price(&ticks[i])
+		if !p.IsPositive() {
+			continue
+		}
+
+		points = append(points, point{ts: max(ticks[i].TS, from), price: p})
+	}
+
+	var stats models.PriceStats
+	if len(points) == 0 {
+		return stats
+	}
+
+	var (
+		weighted = decimal.Zero
+		duration int64
+		squares  float64
+	)
+
+	stats.Min, stats.Max = points[0].price, points[0].price
+
+	for i, p := range points {
+		end := to
+		if i+1 < len(points) {
+			end = points[i+1].ts
+		}
+
+		weighted = weighted.Add(p.price.Mul(decimal.NewFromInt(end - p.ts)))
+		duration += end - p.ts
+
+		stats.Min = decimal.Min(stats.Min, p.price)
+		stats.Max = decimal.Max(stats.Max, p.price)
+
+		if i > 0 {
+			r := math.Log(p.price.Div(points[i-1].price).InexactFloat64())
+			squares += r * r
+		}
+	}
+
+	stats.TWAP = points[len(points)-1].price
+	if duration > 0 {
+		stats.TWAP = weighted.Div(decimal.NewFromInt(duration))
+	}
+
+	stats.TWAP = market.Round(stats.TWAP)
+	stats.Min = market.Round(stats.Min)
+	stats.Max = market.Round(stats.Max)
+
+	stats.Volatility = math.Sqrt(squares)
+
+	sorted := make([]decimal.Decimal, 0, len(points))
+	for _, p := range points {
+		sorted = append(sorted, p.price)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LessThan(sorted[j]) })
+
+	for _, pct := range percentiles {
+		stats.Percentiles = append(stats.Percentiles, models.PercentileValue{
+			Percentile: pct,
+			Value:      market.Round(percentile(sorted, pct)),
+		})
+	}
+
+	return stats
+}
+
+// percentile returns the pct percentile of sorted values, interpolating
+// linearly between the closest ranks.
+func percentile(sorted []decimal.Decimal, pct float64) decimal.Decimal {
+	rank := pct / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	if lower == upper {
+		return sorted[lower]
+	}
+
+	frac := decimal.NewFromFloat(rank - float64(lower))
+
+	return sorted[lower].Add(sorted[upper].Sub(sorted[lower]).Mul(frac))
+}
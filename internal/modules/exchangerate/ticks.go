@@ -0,0 +1,173 @@
+package exchangerate
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// recentTicks keeps the latest rates stored by this instance, and with the rate
+// fan-out by the others too, per market and ordered by ts, so that statistics over
+// short windows skip the database. The last fetched rate of each market is kept
+// too, whether it was stored or not. The ticks go without their order books, only
+// the book of the most recent tick of each market is kept.
+type recentTicks struct {
+	mu       sync.RWMutex
+	window   int64
+	maxTicks int
+	markets  map[string][]models.ExchangeRate
+	fetched  map[string]models.ExchangeRate
+	// books are nil for the markets whose most recent tick came without its book,
+	// e.g. from the rate fan-out.
+	books map[string]*models.OrderBook
+}
+
+func newRecentTicks(window time.Duration, maxTicks int) *recentTicks {
+	return &recentTicks{
+		window:   int64(window / time.Second),
+		maxTicks: maxTicks,
+		markets:  make(map[string][]models.ExchangeRate),
+		fetched:  make(map[string]models.ExchangeRate),
+		books:    make(map[string]*models.OrderBook),
+	}
+}
+
+// fetch records rate as the last fetched rate of its market.
+func (t *recentTicks) fetch(rate *models.ExchangeRate) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tick := *rate
+	tick.Book = nil
+
+	t.fetched[rate.Market] = tick
+}
+
+// lastFetched returns the last fetched rate of the market.
+func (t *recentTicks) lastFetched(market string) (*models.ExchangeRate, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	rate, ok := t.fetched[market]
+	if !ok {
+		return nil, false
+	}
+
+	return &rate, true
+}
+
+// add records rate and evicts ticks that are older than the window or exceed
+// the size limit. The newest evicted tick is kept as it is still in effect at
+// the beginning of the window. A rate that is kept already, or older than the
+// kept ticks, is skipped; add reports whether rate was recorded.
+func (t *recentTicks) add(rate *models.ExchangeRate) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ticks := t.markets[rate.Market]
+
+	// The ticks before the first kept one were evicted, the rate would be too.
+	if len(ticks) > 0 && rate.TS < ticks[0].TS {
+		return false
+	}
+
+	i := sort.Search(len(ticks), func(i int) bool { return ticks[i].TS > rate.TS })
+
+	for j := i - 1; j >= 0 && ticks[j].TS == rate.TS; j-- {
+		if sameTick(&ticks[j], rate) {
+			return false
+		}
+	}
+
+	ticks = append(ticks, models.ExchangeRate{})
+	copy(ticks[i+1:], ticks[i:])
+	ticks[i] = *rate
+	ticks[i].Book = nil
+
+	if i == len(ticks)-1 {
+		t.books[rate.Market] = rate.Book
+	}
+
+	cutoff := ticks[len(ticks)-1].TS - t.window
+	first := sort.Search(len(ticks), func(i int) bool { return ticks[i].TS >= cutoff })
+	if first > 0 {
+		first--
+	}
+
+	if len(ticks)-first > t.maxTicks {
+		first = len(ticks) - t.maxTicks
+	}
+
+	if first > 0 {
+		ticks = append(ticks[:0:0], ticks[first:]...)
+	}
+
+	t.markets[rate.Market] = ticks
+
+	if fetched, ok := t.fetched[rate.Market]; !ok || !rate.FetchedAt.Before(fetched.FetchedAt) {
+		tick := *rate
+		tick.Book = nil
+
+		t.fetched[rate.Market] = tick
+	}
+
+	return true
+}
+
+// sameTick reports whether a and b are the same snapshot. A rate read back from
+// the database has its times rounded to microseconds, and no ID when it was saved
+// in the write-behind mode.
+func sameTick(a, b *models.ExchangeRate) bool {
+	return a.TS == b.TS &&
+		a.RequestID == b.RequestID &&
+		a.Source == b.Source &&
+		a.FetchedAt.Round(time.Microsecond).Equal(b.FetchedAt.Round(time.Microsecond))
+}
+
+// between returns the ticks of the market in [from, to) together with the last
+// tick before from. ok is false when the kept ticks do not reach back to from.
+func (t *recentTicks) between(market string, from, to int64) (prior *models.ExchangeRate, ticks []models.ExchangeRate, ok bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	all := t.markets[market]
+	if len(all) == 0 || all[0].TS > from {
+		return nil, nil, false
+	}
+
+	first := sort.Search(len(all), func(i int) bool { return all[i].TS >= from })
+	last := sort.Search(len(all), func(i int) bool { return all[i].TS >= to })
+
+	if first > 0 {
+		p := all[first-1]
+		prior = &p
+	}
+
+	return prior, append([]models.ExchangeRate(nil), all[first:last]...), true
+}
+
+// latest returns the most recent tick of the market.
+func (t *recentTicks) latest(market string) (*models.ExchangeRate, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	ticks := t.markets[market]
+	if len(ticks) == 0 {
+		return nil, false
+	}
+
+	rate := ticks[len(ticks)-1]
+
+	return &rate, true
+}
+
+// latestBook returns the order book of the most recent tick of the market, nil
+// when it is not known.
+func (t *recentTicks) latestBook(market string) *models.OrderBook {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.books[market]
+}
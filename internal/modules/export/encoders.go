@@ -0,0 +1,227 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+// encoder writes the exported rates in a file format.
+type encoder interface {
+	// write writes a page of rates and hands the complete part of the file to
+	// the writer.
+	write(rates []*models.ExchangeRate) error
+	// close writes the rest of the file.
+	close() error
+}
+
+// column - an exported column, text renders it in CSV and value in Parquet
+type column struct {
+	node  parquet.Node
+	text  func(rate *models.ExchangeRate, loc *time.Location) string
+	value func(rate *models.ExchangeRate) parquet.Value
+}
+
+// csvTimeLayout - timestamps in CSV, with the offset of the requested time zone
+const csvTimeLayout = "2006-01-02T15:04:05.000Z07:00"
+
+var columns = map[string]column{
+	"id": {
+		node: parquet.Int(64),
+		text: func(rate *models.ExchangeRate, _ *time.Location) string {
+			return strconv.FormatInt(rate.ID, 10)
+		},
+		value: func(rate *models.ExchangeRate) parquet.Value {
+			return parquet.Int64Value(rate.ID)
+		},
+	},
+	"market": stringColumn(func(rate *models.ExchangeRate) string { return rate.Market }),
+	"ts":     timeColumn(func(rate *models.ExchangeRate) int64 { return rate.TS }),
+	// Prices are kept as decimal strings, their scale differs between markets.
+	"ask_price": stringColumn(func(rate *models.ExchangeRate) string { return rate.AskPrice.String() }),
+	"bid_price": stringColumn(func(rate *models.ExchangeRate) string { return rate.BidPrice.String() }),
+	"source":    stringColumn(func(rate *models.ExchangeRate) string { return rate.Source }),
+	"fetched_at": {
+		node: parquet.Timestamp(parquet.Millisecond),
+		text: func(rate *models.ExchangeRate, loc *time.Location) string {
+			return rate.FetchedAt.In(loc).Format(csvTimeLayout)
+		},
+		value: func(rate *models.ExchangeRate) parquet.Value {
+			return parquet.Int64Value(rate.FetchedAt.UnixMilli())
+		},
+	},
+	"latency_ms": {
+		node: parquet.Int(64),
+		text: func(rate *models.ExchangeRate, _ *time.Location) string {
+			return strconv.FormatInt(rate.Latency.Milliseconds(), 10)
+		},
+		value: func(rate *models.ExchangeRate) parquet.Value {
+			return parquet.Int64Value(rate.Latency.Milliseconds())
+		},
+	},
+	"request_id": stringColumn(func(rate *models.ExchangeRate) string { return rate.RequestID }),
+	// Empty while the snapshot is the latest one.
+	"valid_to": {
+		node: parquet.Optional(parquet.Timestamp(parquet.Millisecond)),
+		text: func(rate *models.ExchangeRate, loc *time.Location) string {
+			if rate.ValidTo == 0 {
+				return ""
+			}
+
+			return time.Unix(rate.ValidTo, 0).In(loc).Format(csvTimeLayout)
+		},
+		value: func(rate *models.ExchangeRate) parquet.Value {
+			if rate.ValidTo == 0 {
+				return parquet.NullValue()
+			}
+
+			return parquet.Int64Value(rate.ValidTo * 1000)
+		},
+	},
+}
+
+func stringColumn(get func(rate *models.ExchangeRate) string) column {
+	return column{
+		node: parquet.String(),
+		text: func(rate *models.ExchangeRate, _ *time.Location) string {
+			return get(rate)
+		},
+		value: func(rate *models.ExchangeRate) parquet.Value {
+			return parquet.ByteArrayValue([]byte(get(rate)))
+		},
+	}
+}
+
+// timeColumn - column of a Unix timestamp in seconds
+func timeColumn(get func(rate *models.ExchangeRate) int64) column {
+	return column{
+		node: parquet.Timestamp(parquet.Millisecond),
+		text: func(rate *models.ExchangeRate, loc *time.Location) string {
+			return time.Unix(get(rate), 0).In(loc).Format(csvTimeLayout)
+		},
+		value: func(rate *models.ExchangeRate) parquet.Value {
+			return parquet.Int64Value(get(rate) * 1000)
+		},
+	}
+}
+
+// csvEncoder writes a header line with the column names and a line per rate.
+type csvEncoder struct {
+	w       *csv.Writer
+	columns []column
+	loc     *time.Location
+	record  []string
+	header  []string
+}
+
+func newCSVEncoder(w io.Writer, names []string, loc *time.Location) *csvEncoder {
+	enc := &csvEncoder{
+		w:      csv.NewWriter(w),
+		loc:    loc,
+		record: make([]string, len(names)),
+		header: names,
+	}
+
+	for _, name := range names {
+		enc.columns = append(enc.columns, columns[name])
+	}
+
+	return enc
+}
+
+func (e *csvEncoder) write(rates []*models.ExchangeRate) error {
+	if e.header != nil {
+		if err := e.w.Write(e.header); err != nil {
+			return err
+		}
+
+		e.header = nil
+	}
+
+	for _, rate := range rates {
+		for i, c := range e.columns {
+			e.record[i] = c.text(rate, e.loc)
+		}
+
+		if err := e.w.Write(e.record); err != nil {
+			return err
+		}
+	}
+
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+func (e *csvEncoder) close() error {
+	// The header is written even when there are no rates.
+	return e.write(nil)
+}
+
+// parquetEncoder writes a Parquet file with a column per exported column. The
+// timestamps are stored adjusted to UTC, the requested time zone is recorded in
+// the "timezone" key of the file metadata.
+type parquetEncoder struct {
+	w *parquet.Writer
+	// columns in the order of the schema leaves.
+	columns []column
+	rows    []parquet.Row
+}
+
+func newParquetEncoder(w io.Writer, names []string, loc *time.Location, rowGroupSize int64) *parquetEncoder {
+	group := make(parquet.Group, len(names))
+	for _, name := range names {
+		group[name] = columns[name].node
+	}
+
+	schema := parquet.NewSchema("rates", group)
+
+	enc := &parquetEncoder{
+		w: parquet.NewWriter(w, schema,
+			parquet.Compression(&parquet.Zstd),
+			parquet.MaxRowsPerRowGroup(rowGroupSize),
+			parquet.KeyValueMetadata("timezone", loc.String()),
+		),
+	}
+
+	// The fields of a group are ordered by name.
+	for _, path := range schema.Columns() {
+		enc.columns = append(enc.columns, columns[path[0]])
+	}
+
+	return enc
+}
+
+func (e *parquetEncoder) write(rates []*models.ExchangeRate) error {
+	e.rows = e.rows[:0]
+
+	for _, rate := range rates {
+		row := make(parquet.Row, len(e.columns))
+
+		for i, c := range e.columns {
+			v := c.value(rate)
+
+			definition := 0
+			if c.node.Optional() && !v.IsNull() {
+				definition = 1
+			}
+
+			row[i] = v.Level(0, definition, i)
+		}
+
+		e.rows = append(e.rows, row)
+	}
+
+	_, err := e.w.WriteRows(e.rows)
+
+	return err
+}
+
+func (e *parquetEncoder) close() error {
+	return e.w.Close()
+}
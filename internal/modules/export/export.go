@@ -0,0 +1,171 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+type History interface {
+	ListExchangeRates(ctx context.Context, filter models.RateFilter) ([]*models.ExchangeRate, *models.RateCursor, error)
+	HistoryResolution(market string, ts int64) models.Resolution
+}
+
+// Module writes the stored rate history as a CSV or Parquet file. The history is
+// read page by page and written as it is read, so the size of an export is not
+// bounded by memory.
+type Module struct {
+	log     *slog.Logger
+	cfg     *config.Export
+	history History
+}
+
+func New(log *slog.Logger, cfg *config.Config, history History) (*Module, error) {
+	switch {
+	case cfg.Export.MaxMarkets <= 0:
+		return nil, fmt.Errorf("export max markets must be positive")
+	case cfg.Export.RowGroupSize <= 0:
+		return nil, fmt.Errorf("export row group size must be positive")
+	case cfg.Export.ChunkSizeKB <= 0:
+		return nil, fmt.Errorf("export chunk size must be positive")
+	}
+
+	return &Module{
+		log:     log.With("component", "export"),
+		cfg:     &cfg.Export,
+		history: history,
+	}, nil
+}
+
+// ChunkSize returns the maximum size of a streamed file chunk in bytes.
+func (m *Module) ChunkSize() int {
+	return m.cfg.ChunkSizeKB << 10
+}
+
+// ExportRates writes the history of the markets over [req.From, req.To) to w, the
+// markets one after another in the requested order and the rates of a market by
+// ts. Returns the number of written rates.
+func (m *Module) ExportRates(ctx context.Context, req *models.ExportRequest, w io.Writer) (int64, error) {
+	if err := m.validate(req); err != nil {
+		return 0, err
+	}
+
+	columns := req.Columns
+	if len(columns) == 0 {
+		columns = models.ExportColumns
+	}
+
+	loc := req.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var enc encoder
+
+	switch req.Format {
+	case models.ExportFormatCSV:
+		enc = newCSVEncoder(w, columns, loc)
+	case models.ExportFormatParquet:
+		enc = newParquetEncoder(w, columns, loc, m.cfg.RowGroupSize)
+	}
+
+	var n int64
+
+	for _, market := range req.Markets {
+		written, err := m.exportMarket(ctx, enc, market, req.From, req.To)
+		n += written
+
+		if err != nil {
+			return n, fmt.Errorf("could not export market %s: %w", market, err)
+		}
+	}
+
+	if err := enc.close(); err != nil {
+		return n, fmt.Errorf("could not finish export: %w", err)
+	}
+
+	m.log.InfoContext(ctx, "exported rates",
+		"markets", req.Markets,
+		"from", req.From,
+		"to", req.To,
+		"format", req.Format,
+		"rows", n,
+	)
+
+	return n, nil
+}
+
+// exportMarket writes the history of the market page by page.
+func (m *Module) exportMarket(ctx context.Context, enc encoder, market string, from, to int64) (int64, error) {
+	filter := models.RateFilter{
+		Market: market,
+		From:   from,
+		To:     to,
+		// Every page must come from the same history.
+		Resolution: m.history.HistoryResolution(market, from),
+	}
+
+	var n int64
+
+	for {
+		rates, next, err := m.history.ListExchangeRates(ctx, filter)
+		if err != nil {
+			return n, err
+		}
+
+		if err = enc.write(rates); err != nil {
+			return n, err
+		}
+
+		n += int64(len(rates))
+
+		if next == nil {
+			return n, nil
+		}
+
+		filter.After = next
+	}
+}
+
+func (m *Module) validate(req *models.ExportRequest) error {
+	switch {
+	case len(req.Markets) == 0:
+		return fmt.Errorf("%w: markets are required", models.ErrInvalidExport)
+	case len(req.Markets) > m.cfg.MaxMarkets:
+		return fmt.Errorf("%w: at most %d markets can be exported at once", models.ErrInvalidExport, m.cfg.MaxMarkets)
+	case req.From <= 0:
+		return fmt.Errorf("%w: from must be positive", models.ErrInvalidExport)
+	case req.To <= req.From:
+		return fmt.Errorf("%w: to must be greater than from", models.ErrInvalidExport)
+	case req.Format != models.ExportFormatCSV && req.Format != models.ExportFormatParquet:
+		return fmt.Errorf("%w: unknown format %q", models.ErrInvalidExport, req.Format)
+	}
+
+	for i, market := range req.Markets {
+		if market == "" {
+			return fmt.Errorf("%w: market must not be empty", models.ErrInvalidExport)
+		}
+
+		if slices.Contains(req.Markets[:i], market) {
+			return fmt.Errorf("%w: market %s is repeated", models.ErrInvalidExport, market)
+		}
+	}
+
+	for i, column := range req.Columns {
+		if !slices.Contains(models.ExportColumns, column) {
+			return fmt.Errorf("%w: unknown column %q", models.ErrInvalidExport, column)
+		}
+
+		if slices.Contains(req.Columns[:i], column) {
+			return fmt.Errorf("%w: column %s is repeated", models.ErrInvalidExport, column)
+		}
+	}
+
+	return nil
+}
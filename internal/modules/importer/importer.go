@@ -0,0 +1,545 @@
+package importer
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+type Storage interface {
+	ImportExchangeRates(ctx context.Context, rates []*models.ExchangeRate, dryRun bool) ([]int, error)
+}
+
+// Options - file to import and how
+type Options struct {
+	Path string
+	// RejectsPath - CSV report of the rows that were not imported, with the reasons.
+	RejectsPath string
+	// DryRun validates the file and checks it against the stored history without
+	// storing anything.
+	DryRun bool
+	// Restart ignores the progress of an interrupted import of the file.
+	Restart bool
+	// Location of the timestamps without an offset.
+	Location *time.Location
+}
+
+// Report - outcome of an import
+type Report struct {
+	// Resumed is the number of lines skipped as imported by an interrupted run.
+	Resumed  int64
+	Rows     int64
+	Imported int64
+	// Stored is the number of rows whose market already has a rate at their ts.
+	Stored int64
+	// Duplicates is the number of rows repeating an earlier row of the file.
+	Duplicates int64
+	// Rejected is the number of rows that are not imported, the stored ones and
+	// the duplicates included.
+	Rejected int64
+}
+
+// Module loads historical rates from a CSV file with a header line. The columns
+// market, ts, ask_price and bid_price are required; source, fetched_at, latency_ms
+// and request_id are optional, id and valid_to of the exported files are ignored.
+// A row is identified by its market and ts.
+//
+// The rows are loaded in batches of a transaction each. The line the last batch
+// ended at is kept in a state file next to the imported one, so that an
+// interrupted import resumes after it; the rows stored already are skipped
+// anyway, so a batch loaded twice is not stored twice.
+type Module struct {
+	log     *slog.Logger
+	cfg     *config.Import
+	storage Storage
+}
+
+func New(log *slog.Logger, cfg *config.Config, storage Storage) (*Module, error) {
+	if cfg.Import.BatchSize <= 0 {
+		return nil, fmt.Errorf("import batch size must be positive")
+	}
+
+	return &Module{
+		log:     log.With("component", "importer"),
+		cfg:     &cfg.Import,
+		storage: storage,
+	}, nil
+}
+
+// importColumns - columns of the imported file, required ones first
+var importColumns = []string{
+	"market", "ts", "ask_price", "bid_price", "source", "fetched_at", "latency_ms", "request_id", "id", "valid_to",
+}
+
+// requiredColumns - number of the leading importColumns that are required
+const requiredColumns = 4
+
+// importRow - a row of the file waiting for its batch to be loaded
+type importRow struct {
+	line   int64
+	rate   *models.ExchangeRate
+	record []string
+}
+
+// rejectedRow - a row that is not imported
+type rejectedRow struct {
+	line   int64
+	reason string
+	record []string
+}
+
+// rateKey identifies a rate of the imported history.
+type rateKey struct {
+	market string
+	ts     int64
+}
+
+// Import loads the rates of the file and writes the rejected rows to the report.
+func (m *Module) Import(ctx context.Context, opts Options) (*Report, error) {
+	file, err := os.Open(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	st := &state{
+		path:    opts.Path + ".import-state",
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}
+
+	if !opts.DryRun && !opts.Restart {
+		if err = st.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	// A resumed import appends to the report of the interrupted one.
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if st.Line > 0 {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	rejectsFile, err := os.OpenFile(opts.RejectsPath, flags, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	defer rejectsFile.Close()
+
+	r := csv.NewReader(file)
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read header: %w", err)
+	}
+
+	// Spreadsheets put a byte order mark in front of the file.
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	columns, err := headerColumns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rejects := csv.NewWriter(rejectsFile)
+
+	if info, err := rejectsFile.Stat(); err == nil && info.Size() == 0 {
+		if err = rejects.Write(append([]string{"line", "reason"}, header...)); err != nil {
+			return nil, err
+		}
+	}
+
+	imp := &importRun{
+		Module:  m,
+		opts:    opts,
+		columns: columns,
+		rejects: rejects,
+		state:   st,
+		seen:    make(map[rateKey]int64),
+		report:  &Report{},
+		now:     time.Now(),
+	}
+
+	if st.Line > 0 {
+		m.log.InfoContext(ctx, "resuming import", "file", opts.Path, "after_line", st.Line)
+	}
+
+	if err = imp.run(ctx, r); err != nil {
+		return imp.report, err
+	}
+
+	if !opts.DryRun {
+		if err = st.remove(); err != nil {
+			return imp.report, err
+		}
+	}
+
+	m.log.InfoContext(ctx, "import finished",
+		"file", opts.Path,
+		"dry_run", opts.DryRun,
+		"rows", imp.report.Rows,
+		"imported", imp.report.Imported,
+		"stored", imp.report.Stored,
+		"duplicates", imp.report.Duplicates,
+		"rejected", imp.report.Rejected,
+		"resumed", imp.report.Resumed,
+	)
+
+	return imp.report, nil
+}
+
+// importRun - state of a single import of a file
+type importRun struct {
+	*Module
+
+	opts    Options
+	columns map[string]int
+	rejects *csv.Writer
+	state   *state
+	// seen holds the line of every rate of the file read so far.
+	seen   map[rateKey]int64
+	report *Report
+	now    time.Time
+
+	batch    []importRow
+	rejected []rejectedRow
+}
+
+func (imp *importRun) run(ctx context.Context, r *csv.Reader) error {
+	var line int64
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+
+		switch {
+		case errors.As(err, &parseErr):
+			line = int64(parseErr.StartLine)
+		case err != nil:
+			return fmt.Errorf("could not read file: %w", err)
+		default:
+			l, _ := r.FieldPos(0)
+			line = int64(l)
+		}
+
+		if line <= imp.state.Line {
+			imp.report.Resumed++
+
+			continue
+		}
+
+		imp.report.Rows++
+
+		if err != nil {
+			imp.reject(line, parseErr.Err.Error(), record)
+		} else {
+			imp.add(line, record)
+		}
+
+		if len(imp.batch) >= imp.cfg.BatchSize {
+			if err = imp.flush(ctx, line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return imp.flush(ctx, line)
+}
+
+// add validates the row and adds it to the batch or rejects it.
+func (imp *importRun) add(line int64, record []string) {
+	rate, err := imp.parse(record)
+	if err != nil {
+		imp.reject(line, err.Error(), record)
+
+		return
+	}
+
+	key := rateKey{market: rate.Market, ts: rate.TS}
+
+	if first, ok := imp.seen[key]; ok {
+		imp.report.Duplicates++
+		imp.reject(line, fmt.Sprintf("duplicate of line %d", first), record)
+
+		return
+	}
+
+	imp.seen[key] = line
+	imp.batch = append(imp.batch, importRow{line: line, rate: rate, record: record})
+}
+
+func (imp *importRun) reject(line int64, reason string, record []string) {
+	imp.report.Rejected++
+	imp.rejected = append(imp.rejected, rejectedRow{line: line, reason: reason, record: record})
+}
+
+// flush loads the batch, writes the rejected rows read so far to the report and
+// saves the progress up to line.
+func (imp *importRun) flush(ctx context.Context, line int64) error {
+	if len(imp.batch) > 0 {
+		rates := make([]*models.ExchangeRate, len(imp.batch))
+		for i, row := range imp.batch {
+			rates[i] = row.rate
+		}
+
+		stored, err := imp.storage.ImportExchangeRates(ctx, rates, imp.opts.DryRun)
+		if err != nil {
+			return fmt.Errorf("could not load rows up to line %d: %w", line, err)
+		}
+
+		for _, i := range stored {
+			row := imp.batch[i]
+
+			imp.report.Stored++
+			imp.reject(row.line, "already stored", row.record)
+		}
+
+		imp.report.Imported += int64(len(rates) - len(stored))
+	}
+
+	// The report is kept in the order of the file.
+	slices.SortFunc(imp.rejected, func(a, b rejectedRow) int {
+		return int(a.line - b.line)
+	})
+
+	for _, row := range imp.rejected {
+		if err := imp.rejects.Write(append([]string{strconv.FormatInt(row.line, 10), row.reason}, row.record...)); err != nil {
+			return err
+		}
+	}
+
+	imp.rejects.Flush()
+	if err := imp.rejects.Error(); err != nil {
+		return err
+	}
+
+	imp.batch = imp.batch[:0]
+	imp.rejected = imp.rejected[:0]
+
+	if imp.opts.DryRun {
+		return nil
+	}
+
+	imp.state.Line = line
+
+	return imp.state.save()
+}
+
+// parse validates the row and converts it to a rate.
+func (imp *importRun) parse(record []string) (*models.ExchangeRate, error) {
+	field := func(name string) string {
+		if i, ok := imp.columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+
+		return ""
+	}
+
+	rate := &models.ExchangeRate{
+		Market:    strings.ToLower(field("market")),
+		Source:    field("source"),
+		RequestID: field("request_id"),
+	}
+
+	if rate.Market == "" {
+		return nil, errors.New("market is empty")
+	}
+
+	ts, err := parseTime(field("ts"), imp.opts.Location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ts: %w", err)
+	}
+
+	rate.TS = ts.Unix()
+
+	switch {
+	case rate.TS <= 0:
+		return nil, errors.New("ts must be positive")
+	case ts.After(imp.now):
+		return nil, errors.New("ts is in the future")
+	}
+
+	if rate.AskPrice, err = parsePrice(field("ask_price")); err != nil {
+		return nil, fmt.Errorf("invalid ask_price: %w", err)
+	}
+
+	if rate.BidPrice, err = parsePrice(field("bid_price")); err != nil {
+		return nil, fmt.Errorf("invalid bid_price: %w", err)
+	}
+
+	if rate.BidPrice.GreaterThan(rate.AskPrice) {
+		return nil, errors.New("bid_price is greater than ask_price")
+	}
+
+	if rate.Source == "" {
+		rate.Source = imp.cfg.Source
+	}
+
+	rate.FetchedAt = ts
+
+	if value := field("fetched_at"); value != "" {
+		if rate.FetchedAt, err = parseTime(value, imp.opts.Location); err != nil {
+			return nil, fmt.Errorf("invalid fetched_at: %w", err)
+		}
+	}
+
+	if value := field("latency_ms"); value != "" {
+		ms, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || ms < 0 {
+			return nil, fmt.Errorf("invalid latency_ms: %q", value)
+		}
+
+		rate.Latency = time.Duration(ms) * time.Millisecond
+	}
+
+	return rate, nil
+}
+
+// headerColumns returns the index of every column of the header.
+func headerColumns(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		if !slices.Contains(importColumns, name) {
+			return nil, fmt.Errorf("unknown column %q, known columns: %s", name, strings.Join(importColumns, ", "))
+		}
+
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("column %q is repeated", name)
+		}
+
+		columns[name] = i
+	}
+
+	for _, name := range importColumns[:requiredColumns] {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("column %q is required", name)
+		}
+	}
+
+	return columns, nil
+}
+
+// timeLayouts - accepted layouts of the timestamps besides Unix seconds
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05"}
+
+func parseTime(value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("empty")
+	}
+
+	if ts, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(ts, 0), nil
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("can not parse %q", value)
+}
+
+// maxPrice - prices must fit NUMERIC(38, 18)
+var maxPrice = decimal.New(1, 38-models.MaxPricePrecision)
+
+func parsePrice(value string) (decimal.Decimal, error) {
+	price, err := decimal.NewFromString(value)
+
+	switch {
+	case err != nil:
+		return decimal.Decimal{}, fmt.Errorf("not a decimal: %q", value)
+	case !price.IsPositive():
+		return decimal.Decimal{}, errors.New("must be positive")
+	case price.Exponent() < -models.MaxPricePrecision && !price.Equal(price.Truncate(models.MaxPricePrecision)):
+		return decimal.Decimal{}, fmt.Errorf("more than %d decimal places", models.MaxPricePrecision)
+	case price.GreaterThanOrEqual(maxPrice):
+		return decimal.Decimal{}, errors.New("too large")
+	}
+
+	return price, nil
+}
+
+// state - progress of an import, tied to the size and modification time of the file
+type state struct {
+	path string
+
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	// Line is the last line of the file the rows up to are loaded.
+	Line int64 `json:"line"`
+}
+
+// load reads the progress of an interrupted import of the same file.
+func (s *state) load() error {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var saved state
+
+	if err = json.Unmarshal(data, &saved); err != nil {
+		return fmt.Errorf("state file %s: %w", s.path, err)
+	}
+
+	if saved.Size != s.Size || !saved.ModTime.Equal(s.ModTime) {
+		return fmt.Errorf("file changed since the interrupted import, restart it or remove %s", s.path)
+	}
+
+	s.Line = saved.Line
+
+	return nil
+}
+
+// save replaces the state file atomically.
+func (s *state) save() error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(s.path+".tmp", data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(s.path+".tmp", s.path)
+}
+
+func (s *state) remove() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
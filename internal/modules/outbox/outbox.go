@@ -0,0 +1,136 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+type Storage interface {
+	DeliverOutbox(
+		ctx context.Context,
+		limit, maxAttempts int,
+		deliver func(ctx context.Context, events []*models.OutboxEvent) ([]int64, map[int64]error),
+	) (int, bool, error)
+	PurgeDeliveredOutbox(ctx context.Context, before time.Time) (int64, error)
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, event *models.OutboxEvent) error
+}
+
+// Module relays the events of the outbox to the publisher. An event is marked
+// delivered only after it is published, so it is published at least once. The
+// events of a market are published in order: once one of them fails, the later
+// ones wait for it until it is published or dead-lettered after max attempts.
+type Module struct {
+	log       *slog.Logger
+	cfg       *config.Outbox
+	storage   Storage
+	publisher Publisher
+}
+
+func New(log *slog.Logger, cfg *config.Config, storage Storage, publisher Publisher) (*Module, error) {
+	switch {
+	case cfg.Outbox.PollInterval <= 0:
+		return nil, fmt.Errorf("outbox poll interval must be positive")
+	case cfg.Outbox.BatchSize <= 0:
+		return nil, fmt.Errorf("outbox batch size must be positive")
+	case cfg.Outbox.MaxAttempts <= 0:
+		return nil, fmt.Errorf("outbox max attempts must be positive")
+	case cfg.Outbox.Retention < 0:
+		return nil, fmt.Errorf("outbox retention must not be negative")
+	}
+
+	return &Module{
+		log:       log.With("component", "outbox"),
+		cfg:       &cfg.Outbox,
+		storage:   storage,
+		publisher: publisher,
+	}, nil
+}
+
+// Run relays the pending events every poll interval until ctx is done.
+func (m *Module) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.relay(ctx); err != nil {
+			m.log.ErrorContext(ctx, "failed to relay outbox events", "error", err)
+		}
+
+		if err := m.purge(ctx, time.Now()); err != nil {
+			m.log.ErrorContext(ctx, "failed to purge delivered outbox events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay delivers the pending events batch by batch until a batch is not
+// delivered in full.
+func (m *Module) relay(ctx context.Context) error {
+	for ctx.Err() == nil {
+		n, ok, err := m.storage.DeliverOutbox(ctx, m.cfg.BatchSize, m.cfg.MaxAttempts, m.deliver)
+		if err != nil || !ok || n < m.cfg.BatchSize {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Module) deliver(ctx context.Context, events []*models.OutboxEvent) ([]int64, map[int64]error) {
+	var (
+		delivered = make([]int64, 0, len(events))
+		failed    = make(map[int64]error)
+		blocked   = make(map[string]bool)
+	)
+
+	for _, event := range events {
+		if blocked[event.Market] {
+			continue
+		}
+
+		if err := m.publisher.Publish(ctx, event); err != nil {
+			if event.Attempts+1 >= m.cfg.MaxAttempts {
+				m.log.ErrorContext(ctx, "outbox event dead-lettered",
+					"id", event.ID, "market", event.Market, "attempts", event.Attempts+1, "error", err)
+			} else {
+				m.log.WarnContext(ctx, "failed to publish outbox event",
+					"id", event.ID, "market", event.Market, "attempts", event.Attempts+1, "error", err)
+			}
+
+			failed[event.ID] = err
+			blocked[event.Market] = true
+
+			continue
+		}
+
+		delivered = append(delivered, event.ID)
+	}
+
+	return delivered, failed
+}
+
+func (m *Module) purge(ctx context.Context, now time.Time) error {
+	n, err := m.storage.PurgeDeliveredOutbox(ctx, now.Add(-m.cfg.Retention))
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		m.log.DebugContext(ctx, "delivered outbox events purged", "events", n)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+type RuleStorage interface {
+	ListPricingRules(ctx context.Context) ([]*models.PricingRule, error)
+}
+
+// Module picks the pricing rules of clients. The rules are cached for the
+// refresh interval, so that they can be consulted on every rate request.
+type Module struct {
+	log             *slog.Logger
+	storage         RuleStorage
+	refreshInterval time.Duration
+
+	mu       sync.RWMutex
+	rules    map[string][]*models.PricingRule
+	loadedAt time.Time
+}
+
+func New(log *slog.Logger, cfg *config.Config, storage RuleStorage) *Module {
+	return &Module{
+		log:             log,
+		storage:         storage,
+		refreshInterval: cfg.Pricing.RefreshInterval,
+	}
+}
+
+// Rule returns the pricing rule of the client for amount of the market, or nil
+// when the client has none. Rules of the market take precedence over the rules
+// applied to every market.
+func (m *Module) Rule(ctx context.Context, clientID, market string, amount decimal.Decimal) (*models.PricingRule, error) {
+	if clientID == "" {
+		return nil, nil
+	}
+
+	rules, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var marketRule, anyMarketRule *models.PricingRule
+
+	for _, rule := range rules[clientID] {
+		if rule.MinAmount.GreaterThan(amount) {
+			continue
+		}
+
+		switch rule.Market {
+		case market:
+			if marketRule == nil || rule.MinAmount.GreaterThan(marketRule.MinAmount) {
+				marketRule = rule
+			}
+		case "":
+			if anyMarketRule == nil || rule.MinAmount.GreaterThan(anyMarketRule.MinAmount) {
+				anyMarketRule = rule
+			}
+		}
+	}
+
+	if marketRule != nil {
+		return marketRule, nil
+	}
+
+	return anyMarketRule, nil
+}
+
+// load returns the cached rules by client, reloading them once the refresh
+// interval has passed. A stale copy is served while the storage is failing.
+func (m *Module) load(ctx context.Context) (map[string][]*models.PricingRule, error) {
+	m.mu.RLock()
+	rules, loadedAt := m.rules, m.loadedAt
+	m.mu.RUnlock()
+
+	if rules != nil && time.Since(loadedAt) < m.refreshInterval {
+		return rules, nil
+	}
+
+	list, err := m.storage.ListPricingRules(ctx)
+	if err != nil {
+		if rules != nil {
+			m.log.WarnContext(ctx, "failed to reload pricing rules", "error", err)
+
+			return rules, nil
+		}
+
+		return nil, fmt.Errorf("could not load pricing rules: %w", err)
+	}
+
+	rules = make(map[string][]*models.PricingRule)
+	for _, rule := range list {
+		rules[rule.ClientID] = append(rules[rule.ClientID], rule)
+	}
+
+	m.mu.Lock()
+	m.rules, m.loadedAt = rules, time.Now()
+	m.mu.Unlock()
+
+	return rules, nil
+}
@@ -0,0 +1,105 @@
+package quote
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+	"github.com/KVSH-user/ExchangeRateService/internal/utils"
+)
+
+type Storage interface {
+	SaveQuote(ctx context.Context, quote *models.Quote) error
+	RedeemQuote(ctx context.Context, id, clientID string) (*models.Quote, error)
+}
+
+type RateQuoter interface {
+	QuoteExchangeRate(ctx context.Context, market, clientID string, amount decimal.Decimal) (*models.QuotedRate, error)
+}
+
+// Module locks quoted rates for a limited time and redeems them once.
+type Module struct {
+	log     *slog.Logger
+	cfg     *config.Quotes
+	storage Storage
+	rates   RateQuoter
+	signer  *utils.Signer
+}
+
+func New(log *slog.Logger, cfg *config.Config, storage Storage, rates RateQuoter) *Module {
+	secret := cfg.Quotes.Secret
+	if secret == "" {
+		log.Warn("Quote secret is not configured, quotes will not be redeemable after a restart")
+
+		secret = rand.Text()
+	}
+
+	return &Module{
+		log:     log,
+		cfg:     &cfg.Quotes,
+		storage: storage,
+		rates:   rates,
+		signer:  utils.NewSigner(secret),
+	}
+}
+
+// CreateQuote locks the current rate of the market for amount, as priced for
+// the client, for ttl. A zero ttl uses the default, longer ones are capped.
+func (m *Module) CreateQuote(ctx context.Context, market, clientID string, amount decimal.Decimal, ttl time.Duration) (*models.Quote, error) {
+	switch {
+	case ttl <= 0:
+		ttl = m.cfg.DefaultTTL
+	case ttl > m.cfg.MaxTTL:
+		ttl = m.cfg.MaxTTL
+	}
+
+	quoted, err := m.rates.QuoteExchangeRate(ctx, market, clientID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &models.Quote{
+		ID:            m.signer.Sign([]byte(rand.Text())),
+		ClientID:      clientID,
+		Market:        market,
+		Amount:        amount,
+		AskPrice:      quoted.AskPrice,
+		BidPrice:      quoted.BidPrice,
+		RawAskPrice:   quoted.Rate.AskPrice,
+		RawBidPrice:   quoted.Rate.BidPrice,
+		PricingRuleID: quoted.RuleID,
+		RateTS:        quoted.Rate.TS,
+		ExpiresAt:     time.Now().Add(ttl),
+	}
+
+	if err = m.storage.SaveQuote(ctx, quote); err != nil {
+		return nil, fmt.Errorf("could not save quote: %w", err)
+	}
+
+	m.log.InfoContext(ctx, "quote created", "market", market, "client_id", clientID, "expires_at", quote.ExpiresAt)
+
+	return quote, nil
+}
+
+// RedeemQuote marks the quote of the client as used and returns it. Quotes
+// with a forged id are rejected without a database round trip.
+func (m *Module) RedeemQuote(ctx context.Context, id, clientID string) (*models.Quote, error) {
+	if _, err := m.signer.Verify(id); err != nil {
+		return nil, fmt.Errorf("could not redeem quote: %w", models.ErrQuoteNotFound)
+	}
+
+	quote, err := m.storage.RedeemQuote(ctx, id, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("could not redeem quote: %w", err)
+	}
+
+	m.log.InfoContext(ctx, "quote redeemed", "market", quote.Market, "client_id", clientID)
+
+	return quote, nil
+}
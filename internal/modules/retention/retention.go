@@ -0,0 +1,208 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+const (
+	modeDrop    = "drop"
+	modeArchive = "archive"
+
+	day = 24 * time.Hour
+)
+
+type Storage interface {
+	ListRatePartitions(ctx context.Context) ([]*models.RatePartition, error)
+	CreateRatePartition(ctx context.Context, day time.Time) (*models.RatePartition, error)
+	DropRatePartition(ctx context.Context, name string, archive bool) error
+	PurgeExpiredRates(ctx context.Context, markets []string, except bool, before int64, archive bool) (int64, error)
+	GetRollupCheckpoint(ctx context.Context, resolution models.Resolution) (int64, error)
+	WithRetentionLock(ctx context.Context, f func(ctx context.Context) error) (bool, error)
+}
+
+// Module keeps the daily partitions of the rates table created ahead of time
+// and removes the history that is past its retention. Whole partitions go once
+// every market is past its retention, shorter per-market retentions are
+// applied by deleting rows. With the rollup enabled, rates that are not rolled
+// up yet are kept regardless of their retention.
+type Module struct {
+	log     *slog.Logger
+	cfg     *config.Retention
+	rollup  bool
+	storage Storage
+}
+
+func New(log *slog.Logger, cfg *config.Config, storage Storage) (*Module, error) {
+	if cfg.Retention.Interval <= 0 {
+		return nil, fmt.Errorf("retention interval must be positive")
+	}
+
+	if cfg.Retention.Mode != modeDrop && cfg.Retention.Mode != modeArchive {
+		return nil, fmt.Errorf("unknown retention mode %q", cfg.Retention.Mode)
+	}
+
+	for _, market := range cfg.Retention.Markets {
+		if market.Market == "" || market.Retention < 0 {
+			return nil, fmt.Errorf("invalid retention of market %q", market.Market)
+		}
+	}
+
+	return &Module{
+		log:     log.With("component", "retention"),
+		cfg:     &cfg.Retention,
+		rollup:  cfg.Rollup.Enabled,
+		storage: storage,
+	}, nil
+}
+
+// Run maintains the partitions right away and then every interval until ctx
+// is done. One instance at a time maintains them, the others skip their turn.
+func (m *Module) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := m.storage.WithRetentionLock(ctx, func(ctx context.Context) error {
+			return m.maintain(ctx, time.Now())
+		})
+
+		switch {
+		case err != nil:
+			m.log.ErrorContext(ctx, "failed to maintain rate partitions", "error", err)
+		case !ok:
+			m.log.DebugContext(ctx, "rate partitions are maintained by another instance")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Module) maintain(ctx context.Context, now time.Time) error {
+	partitions, err := m.storage.ListRatePartitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = m.premake(ctx, partitions, now); err != nil {
+		return err
+	}
+
+	// Nothing is expired past the rates that are rolled up.
+	horizon := now.Unix()
+
+	if m.rollup {
+		if horizon, err = m.storage.GetRollupCheckpoint(ctx, models.ResolutionMinute); err != nil {
+			return err
+		}
+	}
+
+	return m.expire(ctx, partitions, now, horizon)
+}
+
+// premake creates the daily partitions from today up to PremakeDays ahead that
+// are not covered yet.
+func (m *Module) premake(ctx context.Context, partitions []*models.RatePartition, now time.Time) error {
+	today := now.UTC().Truncate(day)
+
+	for i := range m.cfg.PremakeDays + 1 {
+		start := today.AddDate(0, 0, i)
+		from, to := start.Unix(), start.AddDate(0, 0, 1).Unix()
+
+		covered := false
+
+		for _, partition := range partitions {
+			if partition.Overlaps(from, to) {
+				covered = true
+
+				break
+			}
+		}
+
+		if covered {
+			continue
+		}
+
+		partition, err := m.storage.CreateRatePartition(ctx, start)
+		if err != nil {
+			return err
+		}
+
+		m.log.InfoContext(ctx, "rate partition created", "partition", partition.Name)
+	}
+
+	return nil
+}
+
+// expire removes the partitions that every market is done with and purges the
+// rows of the markets with a shorter retention. Rates with ts from horizon on are
+// kept.
+func (m *Module) expire(ctx context.Context, partitions []*models.RatePartition, now time.Time, horizon int64) error {
+	archive := m.cfg.Mode == modeArchive
+
+	// The longest retention bounds the partitions that can go as a whole, a
+	// zero retention keeps the history forever.
+	longest, forever := m.cfg.Default, m.cfg.Default == 0
+
+	listed := make([]string, 0, len(m.cfg.Markets))
+
+	for _, market := range m.cfg.Markets {
+		listed = append(listed, market.Market)
+		longest = max(longest, market.Retention)
+		forever = forever || market.Retention == 0
+	}
+
+	if !forever {
+		cutoff := min(now.Add(-longest).Unix(), horizon)
+
+		for _, partition := range partitions {
+			if partition.To > cutoff {
+				continue
+			}
+
+			if err := m.storage.DropRatePartition(ctx, partition.Name, archive); err != nil {
+				return err
+			}
+
+			m.log.InfoContext(ctx, "rate partition expired", "partition", partition.Name, "mode", m.cfg.Mode)
+		}
+	}
+
+	for _, market := range m.cfg.Markets {
+		if market.Retention == 0 || (!forever && market.Retention == longest) {
+			continue
+		}
+
+		if err := m.purge(ctx, []string{market.Market}, false, min(now.Add(-market.Retention).Unix(), horizon), archive); err != nil {
+			return err
+		}
+	}
+
+	if m.cfg.Default > 0 && (forever || m.cfg.Default < longest) {
+		return m.purge(ctx, listed, true, min(now.Add(-m.cfg.Default).Unix(), horizon), archive)
+	}
+
+	return nil
+}
+
+func (m *Module) purge(ctx context.Context, markets []string, except bool, before int64, archive bool) error {
+	n, err := m.storage.PurgeExpiredRates(ctx, markets, except, before, archive)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		m.log.InfoContext(ctx, "expired rates purged", "markets", markets, "except", except, "rows", n, "mode", m.cfg.Mode)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package rollup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KVSH-user/ExchangeRateService/internal/config"
+	"github.com/KVSH-user/ExchangeRateService/internal/models"
+)
+
+type Storage interface {
+	GetRollupCheckpoint(ctx context.Context, resolution models.Resolution) (int64, error)
+	GetRollupSourceStart(ctx context.Context, resolution models.Resolution) (int64, bool, error)
+	RollUpRates(ctx context.Context, resolution models.Resolution, to int64) (int64, error)
+	PurgeRollups(ctx context.Context, resolution models.Resolution, before int64) (int64, error)
+}
+
+// Module rolls the raw rates up into 1-minute aggregates and those into hourly
+// ones. Progress is kept in checkpoints advanced together with the aggregates,
+// so an interrupted run resumes where it stopped and concurrent instances do
+// not roll the same buckets twice.
+type Module struct {
+	log     *slog.Logger
+	cfg     *config.Rollup
+	storage Storage
+}
+
+func New(log *slog.Logger, cfg *config.Config, storage Storage) (*Module, error) {
+	switch {
+	case cfg.Rollup.Interval <= 0:
+		return nil, fmt.Errorf("rollup interval must be positive")
+	case cfg.Rollup.Delay < 0:
+		return nil, fmt.Errorf("rollup delay must not be negative")
+	case cfg.Rollup.Chunk < time.Hour:
+		return nil, fmt.Errorf("rollup chunk must be at least an hour")
+	case cfg.Rollup.MinuteRetention < 0 || cfg.Rollup.HourRetention < 0:
+		return nil, fmt.Errorf("rollup retention must not be negative")
+	}
+
+	return &Module{
+		log:     log.With("component", "rollup"),
+		cfg:     &cfg.Rollup,
+		storage: storage,
+	}, nil
+}
+
+// Run rolls the history up right away and then every interval until ctx is
+// done.
+func (m *Module) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.maintain(ctx, time.Now()); err != nil {
+			m.log.ErrorContext(ctx, "failed to roll up rates", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Module) maintain(ctx context.Context, now time.Time) error {
+	end := now.Add(-m.cfg.Delay).Unix()
+
+	minuteTo, err := m.rollUp(ctx, models.ResolutionMinute, end)
+	if err != nil {
+		return err
+	}
+
+	// Hours are rolled up from the minutes, so they never get ahead of them.
+	hourTo, err := m.rollUp(ctx, models.ResolutionHour, minuteTo)
+	if err != nil {
+		return err
+	}
+
+	if m.cfg.MinuteRetention > 0 {
+		// The minutes that are not rolled up into hours yet are kept.
+		before := min(now.Add(-m.cfg.MinuteRetention).Unix(), hourTo)
+
+		if err = m.purge(ctx, models.ResolutionMinute, before); err != nil {
+			return err
+		}
+	}
+
+	if m.cfg.HourRetention > 0 {
+		return m.purge(ctx, models.ResolutionHour, now.Add(-m.cfg.HourRetention).Unix())
+	}
+
+	return nil
+}
+
+// rollUp rolls the complete buckets of the resolution that end before end up
+// chunk by chunk and returns the checkpoint it reached.
+func (m *Module) rollUp(ctx context.Context, resolution models.Resolution, end int64) (int64, error) {
+	step := resolution.Step()
+	end -= end % step
+	chunk := int64(m.cfg.Chunk/time.Second) / step * step
+
+	from, err := m.storage.GetRollupCheckpoint(ctx, resolution)
+	if err != nil {
+		return 0, err
+	}
+
+	if from == 0 {
+		start, ok, err := m.storage.GetRollupSourceStart(ctx, resolution)
+		if err != nil || !ok {
+			return 0, err
+		}
+
+		from = start - start%step
+	}
+
+	for from < end {
+		if err = ctx.Err(); err != nil {
+			return from, err
+		}
+
+		to := min(from+chunk, end)
+
+		n, err := m.storage.RollUpRates(ctx, resolution, to)
+		if err != nil {
+			return from, err
+		}
+
+		m.log.DebugContext(ctx, "rates rolled up", "resolution", resolution, "from", from, "to", to, "buckets", n)
+
+		from = to
+	}
+
+	return from, nil
+}
+
+func (m *Module) purge(ctx context.Context, resolution models.Resolution, before int64) error {
+	n, err := m.storage.PurgeRollups(ctx, resolution, before)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		m.log.InfoContext(ctx, "expired aggregates purged", "resolution", resolution, "buckets", n)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package utils // nolint:revive
+
+import "context"
+
+type actorKey struct{}
+
+// WithActor - returns a copy of ctx carrying the identity of the admin making the call
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext - returns the admin identity carried by ctx, if any
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+
+	return actor
+}
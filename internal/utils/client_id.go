@@ -0,0 +1,17 @@
+package utils // nolint:revive
+
+import "context"
+
+type clientIDKey struct{}
+
+// WithClientID - returns a copy of ctx carrying the id of the calling client
+func WithClientID(ctx context.Context, clientID string) context.Context {
+	return context.WithValue(ctx, clientIDKey{}, clientID)
+}
+
+// ClientIDFromContext - returns the client id carried by ctx, if any
+func ClientIDFromContext(ctx context.Context) string {
+	clientID, _ := ctx.Value(clientIDKey{}).(string)
+
+	return clientID
+}
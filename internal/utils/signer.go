@@ -0,0 +1,57 @@
+package utils // nolint:revive
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidSignature - returned when a signed token is malformed or was tampered with
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Signer - signs opaque tokens with HMAC-SHA256
+type Signer struct {
+	key []byte
+}
+
+// NewSigner - init signer function
+func NewSigner(key string) *Signer {
+	return &Signer{
+		key: []byte(key),
+	}
+}
+
+// Sign - encodes payload into a url-safe token followed by its signature
+func (s *Signer) Sign(payload []byte) string {
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + s.signature(encoded)
+}
+
+// Verify - checks the token signature and returns the signed payload
+func (s *Signer) Verify(token string) ([]byte, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.signature(encoded))) {
+		return nil, ErrInvalidSignature
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+
+	return payload, nil
+}
+
+func (s *Signer) signature(encoded string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(encoded))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,13 @@
+// Package migrations embeds the database migrations into the binary, so they
+// are applied regardless of the working directory.
+package migrations
+
+import "embed"
+
+// PostgresDir - directory of the PostgreSQL migrations, relative to this package
+const PostgresDir = "postgresql"
+
+// FS holds the PostgreSQL migrations under PostgresDir.
+//
+//go:embed postgresql/*.sql
+var FS embed.FS
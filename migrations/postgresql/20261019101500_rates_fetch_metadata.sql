@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE rates
+    ADD COLUMN IF NOT EXISTS source VARCHAR NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS fetched_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS latency_ms BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS request_id VARCHAR NOT NULL DEFAULT '';
+
+-- The fetch time of the rows stored before this migration is unknown,
+-- the exchange timestamp is the closest approximation.
+UPDATE rates SET fetched_at = to_timestamp(ts) WHERE fetched_at IS NULL;
+
+ALTER TABLE rates
+    ALTER COLUMN fetched_at SET DEFAULT NOW(),
+    ALTER COLUMN fetched_at SET NOT NULL;
+
+-- idx_rates_market_ts_id covers lookups by market alone and, scanned backwards, the
+-- latest rates. It stays ascending: the keyset listing compares (market, ts, id) rows,
+-- which an index can only serve with all the columns in the same direction.
+DROP INDEX IF EXISTS idx_rates_market;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_rates_market ON rates(market);
+
+ALTER TABLE rates
+    DROP COLUMN IF EXISTS request_id,
+    DROP COLUMN IF EXISTS latency_ms,
+    DROP COLUMN IF EXISTS fetched_at,
+    DROP COLUMN IF EXISTS source;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Prices are rounded by the service according to the market settings,
+-- the column keeps up to 18 decimal places without truncating them.
+ALTER TABLE rates
+    ALTER COLUMN ask_price TYPE NUMERIC(38, 18),
+    ALTER COLUMN bid_price TYPE NUMERIC(38, 18);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE rates
+    ALTER COLUMN ask_price TYPE DECIMAL(19, 4),
+    ALTER COLUMN bid_price TYPE DECIMAL(19, 4);
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS markets(
+  symbol VARCHAR PRIMARY KEY,
+  base_currency VARCHAR NOT NULL DEFAULT '',
+  quote_currency VARCHAR NOT NULL DEFAULT '',
+  provider VARCHAR NOT NULL DEFAULT 'garantex',
+  price_precision SMALLINT NOT NULL DEFAULT 18,
+  rounding VARCHAR NOT NULL DEFAULT 'half_even',
+  polling_interval_seconds BIGINT NOT NULL DEFAULT 0,
+  staleness_limit_seconds BIGINT NOT NULL DEFAULT 0,
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS markets;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A stored snapshot is in effect from its ts until valid_to, the ts of the next
+-- snapshot of the market; valid_to is NULL while the snapshot is the latest one.
+ALTER TABLE rates ADD COLUMN IF NOT EXISTS valid_to BIGINT;
+
+UPDATE rates r
+SET valid_to = n.next_ts
+FROM (
+  SELECT id, LEAD(ts) OVER (PARTITION BY market ORDER BY ts, id) AS next_ts
+  FROM rates
+) n
+WHERE r.id = n.id
+  AND n.next_ts IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_rates_open ON rates (market) WHERE valid_to IS NULL;
+
+ALTER TABLE markets
+  ADD COLUMN IF NOT EXISTS store_mode VARCHAR NOT NULL DEFAULT 'all',
+  ADD COLUMN IF NOT EXISTS heartbeat_interval_seconds BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE markets
+  DROP COLUMN IF EXISTS heartbeat_interval_seconds,
+  DROP COLUMN IF EXISTS store_mode;
+
+DROP INDEX IF EXISTS idx_rates_open;
+
+ALTER TABLE rates DROP COLUMN IF EXISTS valid_to;
+-- +goose StatementEnd
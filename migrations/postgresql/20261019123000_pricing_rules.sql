@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pricing_rules(
+  id BIGSERIAL PRIMARY KEY,
+  client_id VARCHAR NOT NULL,
+  -- Empty market applies the rule to every market of the client.
+  market VARCHAR NOT NULL DEFAULT '',
+  -- Lower bound of the amount tier, in the base currency.
+  min_amount NUMERIC(38,18) NOT NULL DEFAULT 0,
+  markup_bps INTEGER NOT NULL DEFAULT 0,
+  -- Minimum fee per request, in the quote currency.
+  min_fee NUMERIC(38,18) NOT NULL DEFAULT 0,
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT pricing_rules_tier_key UNIQUE (client_id, market, min_amount),
+  CONSTRAINT pricing_rules_markup_check CHECK (markup_bps >= 0 AND markup_bps < 10000),
+  CONSTRAINT pricing_rules_amounts_check CHECK (min_amount >= 0 AND min_fee >= 0)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pricing_rules;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS quotes(
+  -- Signed quote id handed out to the client.
+  id VARCHAR PRIMARY KEY,
+  client_id VARCHAR NOT NULL DEFAULT '',
+  market VARCHAR NOT NULL,
+  amount NUMERIC(38,18) NOT NULL,
+  ask_price NUMERIC(38,18) NOT NULL,
+  bid_price NUMERIC(38,18) NOT NULL,
+  raw_ask_price NUMERIC(38,18) NOT NULL,
+  raw_bid_price NUMERIC(38,18) NOT NULL,
+  pricing_rule_id BIGINT NOT NULL DEFAULT 0,
+  rate_ts BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  expires_at TIMESTAMPTZ NOT NULL,
+  redeemed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_quotes_expires_at ON quotes (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS quotes;
+-- +goose StatementEnd
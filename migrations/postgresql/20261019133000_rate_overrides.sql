@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS rate_overrides(
+  market VARCHAR PRIMARY KEY,
+  ask_price NUMERIC(38,18) NOT NULL,
+  bid_price NUMERIC(38,18) NOT NULL,
+  reason VARCHAR NOT NULL DEFAULT '',
+  actor VARCHAR NOT NULL,
+  -- NULL keeps the override active until it is cleared.
+  expires_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS rate_override_audit(
+  id BIGSERIAL PRIMARY KEY,
+  market VARCHAR NOT NULL,
+  -- set or clear
+  action VARCHAR NOT NULL,
+  ask_price NUMERIC(38,18),
+  bid_price NUMERIC(38,18),
+  reason VARCHAR NOT NULL DEFAULT '',
+  actor VARCHAR NOT NULL,
+  expires_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_override_audit_market ON rate_override_audit (market, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rate_override_audit;
+DROP TABLE IF EXISTS rate_overrides;
+-- +goose StatementEnd
@@ -0,0 +1,87 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rates becomes partitioned by range of ts: one partition holds everything stored
+-- before tomorrow, daily partitions follow and are created ahead of time by the
+-- retention job, which also drops or archives the expired ones.
+ALTER TABLE rates RENAME TO rates_unpartitioned;
+ALTER TABLE rates_unpartitioned ALTER COLUMN id DROP DEFAULT;
+ALTER SEQUENCE rates_id_seq OWNED BY NONE;
+
+CREATE TABLE rates(
+  id BIGINT NOT NULL DEFAULT nextval('rates_id_seq'),
+  market VARCHAR NOT NULL DEFAULT '',
+  ask_price NUMERIC(38, 18),
+  bid_price NUMERIC(38, 18),
+  ts BIGINT NOT NULL,
+  source VARCHAR NOT NULL DEFAULT '',
+  fetched_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  latency_ms BIGINT NOT NULL DEFAULT 0,
+  request_id VARCHAR NOT NULL DEFAULT '',
+  valid_to BIGINT,
+  PRIMARY KEY (id, ts)
+) PARTITION BY RANGE (ts);
+
+ALTER SEQUENCE rates_id_seq AS BIGINT OWNED BY rates.id;
+
+DO $$
+DECLARE
+  tomorrow BIGINT := (EXTRACT(EPOCH FROM NOW())::BIGINT / 86400 + 1) * 86400;
+  day BIGINT;
+BEGIN
+  EXECUTE format('CREATE TABLE rates_p_legacy PARTITION OF rates FOR VALUES FROM (MINVALUE) TO (%s)', tomorrow);
+
+  FOR day IN SELECT generate_series(tomorrow, tomorrow + 6 * 86400, 86400) LOOP
+    EXECUTE format(
+      'CREATE TABLE %I PARTITION OF rates FOR VALUES FROM (%s) TO (%s)',
+      'rates_p' || to_char(to_timestamp(day) AT TIME ZONE 'UTC', 'YYYYMMDD'), day, day + 86400
+    );
+  END LOOP;
+END $$;
+
+INSERT INTO rates (id, market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id, valid_to)
+SELECT id, market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id, valid_to
+FROM rates_unpartitioned;
+
+DROP TABLE rates_unpartitioned;
+
+CREATE INDEX IF NOT EXISTS idx_rates_market_ts_id ON rates(market, ts, id);
+CREATE INDEX IF NOT EXISTS idx_rates_open ON rates (market) WHERE valid_to IS NULL;
+
+-- Archived partitions are moved here, archived rows of single markets go to rates_expired.
+CREATE SCHEMA IF NOT EXISTS rates_archive;
+CREATE TABLE IF NOT EXISTS rates_archive.rates_expired (LIKE rates INCLUDING DEFAULTS);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP SCHEMA IF EXISTS rates_archive CASCADE;
+
+ALTER TABLE rates ALTER COLUMN id DROP DEFAULT;
+ALTER SEQUENCE rates_id_seq OWNED BY NONE;
+
+CREATE TABLE rates_unpartitioned(
+  id BIGINT PRIMARY KEY DEFAULT nextval('rates_id_seq'),
+  market VARCHAR NOT NULL DEFAULT '',
+  ask_price NUMERIC(38, 18),
+  bid_price NUMERIC(38, 18),
+  ts BIGINT NOT NULL,
+  source VARCHAR NOT NULL DEFAULT '',
+  fetched_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  latency_ms BIGINT NOT NULL DEFAULT 0,
+  request_id VARCHAR NOT NULL DEFAULT '',
+  valid_to BIGINT
+);
+
+INSERT INTO rates_unpartitioned (id, market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id, valid_to)
+SELECT id, market, ask_price, bid_price, ts, source, fetched_at, latency_ms, request_id, valid_to
+FROM rates;
+
+DROP TABLE rates;
+
+ALTER TABLE rates_unpartitioned RENAME TO rates;
+ALTER INDEX rates_unpartitioned_pkey RENAME TO rates_pkey;
+ALTER SEQUENCE rates_id_seq OWNED BY rates.id;
+
+CREATE INDEX IF NOT EXISTS idx_rates_market_ts_id ON rates(market, ts, id);
+CREATE INDEX IF NOT EXISTS idx_rates_open ON rates (market) WHERE valid_to IS NULL;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Aggregates of the rates per bucket: open/high/low/close of both sides, the
+-- number of snapshots and the snapshot the bucket closed with. rates_1m is rolled
+-- up from rates, rates_1h from rates_1m.
+CREATE TABLE IF NOT EXISTS rates_1m(
+  market VARCHAR NOT NULL,
+  bucket BIGINT NOT NULL,
+  ask_open NUMERIC(38, 18),
+  ask_high NUMERIC(38, 18),
+  ask_low NUMERIC(38, 18),
+  ask_close NUMERIC(38, 18),
+  bid_open NUMERIC(38, 18),
+  bid_high NUMERIC(38, 18),
+  bid_low NUMERIC(38, 18),
+  bid_close NUMERIC(38, 18),
+  samples BIGINT NOT NULL,
+  last_ts BIGINT NOT NULL,
+  source VARCHAR NOT NULL DEFAULT '',
+  fetched_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (market, bucket)
+);
+
+CREATE TABLE IF NOT EXISTS rates_1h (LIKE rates_1m INCLUDING ALL);
+
+CREATE INDEX IF NOT EXISTS idx_rates_1m_market_last_ts ON rates_1m(market, last_ts);
+CREATE INDEX IF NOT EXISTS idx_rates_1h_market_last_ts ON rates_1h(market, last_ts);
+
+-- The rates with ts below rolled_to are rolled up into the resolution, rolled_to
+-- is aligned to its buckets.
+CREATE TABLE IF NOT EXISTS rollup_checkpoints(
+  resolution VARCHAR PRIMARY KEY,
+  rolled_to BIGINT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO rollup_checkpoints (resolution) VALUES ('1m'), ('1h') ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rollup_checkpoints;
+DROP TABLE IF EXISTS rates_1h;
+DROP TABLE IF EXISTS rates_1m;
+-- +goose StatementEnd
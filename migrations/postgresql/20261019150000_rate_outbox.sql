@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Events written in the same transaction as the rates, the relay delivers them
+-- in id order and marks them delivered.
+CREATE TABLE IF NOT EXISTS rate_outbox(
+  id BIGSERIAL PRIMARY KEY,
+  type VARCHAR NOT NULL,
+  market VARCHAR NOT NULL,
+  payload JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  attempts INT NOT NULL DEFAULT 0,
+  last_error TEXT,
+  delivered_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_outbox_pending ON rate_outbox(id) WHERE delivered_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_rate_outbox_delivered_at ON rate_outbox(delivered_at) WHERE delivered_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rate_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Order book the rate was taken from: {"asks": [[price, volume, amount], ...], "bids": [...]}.
+-- Large books are compressed by TOAST.
+ALTER TABLE rates ADD COLUMN IF NOT EXISTS book JSONB;
+ALTER TABLE rates_archive.rates_expired ADD COLUMN IF NOT EXISTS book JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE rates_archive.rates_expired DROP COLUMN IF EXISTS book;
+ALTER TABLE rates DROP COLUMN IF EXISTS book;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Events that failed max_attempts deliveries are set aside, so the later events of
+-- their market are not held back by them forever.
+ALTER TABLE rate_outbox ADD COLUMN IF NOT EXISTS dead_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_rate_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_rate_outbox_pending ON rate_outbox(id) WHERE delivered_at IS NULL AND dead_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_rate_outbox_dead_at ON rate_outbox(dead_at) WHERE dead_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_rate_outbox_dead_at;
+DROP INDEX IF EXISTS idx_rate_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_rate_outbox_pending ON rate_outbox(id) WHERE delivered_at IS NULL;
+ALTER TABLE rate_outbox DROP COLUMN IF EXISTS dead_at;
+-- +goose StatementEnd
@@ -23,6 +23,25 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ExchangeRateServiceClient interface {
 	GetRates(ctx context.Context, in *GetRatesRequest, opts ...grpc.CallOption) (*GetRatesResponse, error)
+	BatchGetRates(ctx context.Context, in *BatchGetRatesRequest, opts ...grpc.CallOption) (*BatchGetRatesResponse, error)
+	GetRateAt(ctx context.Context, in *GetRateAtRequest, opts ...grpc.CallOption) (*GetRateAtResponse, error)
+	ListRates(ctx context.Context, in *ListRatesRequest, opts ...grpc.CallOption) (*ListRatesResponse, error)
+	GetRateStats(ctx context.Context, in *GetRateStatsRequest, opts ...grpc.CallOption) (*GetRateStatsResponse, error)
+	GetOrderBookAt(ctx context.Context, in *GetOrderBookAtRequest, opts ...grpc.CallOption) (*GetOrderBookAtResponse, error)
+	ExportRates(ctx context.Context, in *ExportRatesRequest, opts ...grpc.CallOption) (ExchangeRateService_ExportRatesClient, error)
+	SubscribeRates(ctx context.Context, in *SubscribeRatesRequest, opts ...grpc.CallOption) (ExchangeRateService_SubscribeRatesClient, error)
+	// Firm quotes.
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error)
+	RedeemQuote(ctx context.Context, in *RedeemQuoteRequest, opts ...grpc.CallOption) (*RedeemQuoteResponse, error)
+	// Market registry administration.
+	ListMarkets(ctx context.Context, in *ListMarketsRequest, opts ...grpc.CallOption) (*ListMarketsResponse, error)
+	GetMarket(ctx context.Context, in *GetMarketRequest, opts ...grpc.CallOption) (*GetMarketResponse, error)
+	UpsertMarket(ctx context.Context, in *UpsertMarketRequest, opts ...grpc.CallOption) (*UpsertMarketResponse, error)
+	SetMarketEnabled(ctx context.Context, in *SetMarketEnabledRequest, opts ...grpc.CallOption) (*SetMarketEnabledResponse, error)
+	// Manual rate overrides.
+	SetRateOverride(ctx context.Context, in *SetRateOverrideRequest, opts ...grpc.CallOption) (*SetRateOverrideResponse, error)
+	ListRateOverrides(ctx context.Context, in *ListRateOverridesRequest, opts ...grpc.CallOption) (*ListRateOverridesResponse, error)
+	ClearRateOverride(ctx context.Context, in *ClearRateOverrideRequest, opts ...grpc.CallOption) (*ClearRateOverrideResponse, error)
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
@@ -43,6 +62,196 @@ func (c *exchangeRateServiceClient) GetRates(ctx context.Context, in *GetRatesRe
 	return out, nil
 }
 
+func (c *exchangeRateServiceClient) BatchGetRates(ctx context.Context, in *BatchGetRatesRequest, opts ...grpc.CallOption) (*BatchGetRatesResponse, error) {
+	out := new(BatchGetRatesResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/BatchGetRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) GetRateAt(ctx context.Context, in *GetRateAtRequest, opts ...grpc.CallOption) (*GetRateAtResponse, error) {
+	out := new(GetRateAtResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/GetRateAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) ListRates(ctx context.Context, in *ListRatesRequest, opts ...grpc.CallOption) (*ListRatesResponse, error) {
+	out := new(ListRatesResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/ListRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) GetRateStats(ctx context.Context, in *GetRateStatsRequest, opts ...grpc.CallOption) (*GetRateStatsResponse, error) {
+	out := new(GetRateStatsResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/GetRateStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) GetOrderBookAt(ctx context.Context, in *GetOrderBookAtRequest, opts ...grpc.CallOption) (*GetOrderBookAtResponse, error) {
+	out := new(GetOrderBookAtResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/GetOrderBookAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) ExportRates(ctx context.Context, in *ExportRatesRequest, opts ...grpc.CallOption) (ExchangeRateService_ExportRatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExchangeRateService_ServiceDesc.Streams[0], "/exchangerateservice.ExchangeRateService/ExportRates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exchangeRateServiceExportRatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExchangeRateService_ExportRatesClient interface {
+	Recv() (*ExportRatesResponse, error)
+	grpc.ClientStream
+}
+
+type exchangeRateServiceExportRatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *exchangeRateServiceExportRatesClient) Recv() (*ExportRatesResponse, error) {
+	m := new(ExportRatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exchangeRateServiceClient) SubscribeRates(ctx context.Context, in *SubscribeRatesRequest, opts ...grpc.CallOption) (ExchangeRateService_SubscribeRatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExchangeRateService_ServiceDesc.Streams[1], "/exchangerateservice.ExchangeRateService/SubscribeRates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exchangeRateServiceSubscribeRatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExchangeRateService_SubscribeRatesClient interface {
+	Recv() (*SubscribeRatesResponse, error)
+	grpc.ClientStream
+}
+
+type exchangeRateServiceSubscribeRatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *exchangeRateServiceSubscribeRatesClient) Recv() (*SubscribeRatesResponse, error) {
+	m := new(SubscribeRatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exchangeRateServiceClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error) {
+	out := new(CreateQuoteResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/CreateQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) RedeemQuote(ctx context.Context, in *RedeemQuoteRequest, opts ...grpc.CallOption) (*RedeemQuoteResponse, error) {
+	out := new(RedeemQuoteResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/RedeemQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) ListMarkets(ctx context.Context, in *ListMarketsRequest, opts ...grpc.CallOption) (*ListMarketsResponse, error) {
+	out := new(ListMarketsResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/ListMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) GetMarket(ctx context.Context, in *GetMarketRequest, opts ...grpc.CallOption) (*GetMarketResponse, error) {
+	out := new(GetMarketResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/GetMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) UpsertMarket(ctx context.Context, in *UpsertMarketRequest, opts ...grpc.CallOption) (*UpsertMarketResponse, error) {
+	out := new(UpsertMarketResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/UpsertMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) SetMarketEnabled(ctx context.Context, in *SetMarketEnabledRequest, opts ...grpc.CallOption) (*SetMarketEnabledResponse, error) {
+	out := new(SetMarketEnabledResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/SetMarketEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) SetRateOverride(ctx context.Context, in *SetRateOverrideRequest, opts ...grpc.CallOption) (*SetRateOverrideResponse, error) {
+	out := new(SetRateOverrideResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/SetRateOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) ListRateOverrides(ctx context.Context, in *ListRateOverridesRequest, opts ...grpc.CallOption) (*ListRateOverridesResponse, error) {
+	out := new(ListRateOverridesResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/ListRateOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) ClearRateOverride(ctx context.Context, in *ClearRateOverrideRequest, opts ...grpc.CallOption) (*ClearRateOverrideResponse, error) {
+	out := new(ClearRateOverrideResponse)
+	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/ClearRateOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *exchangeRateServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	out := new(HealthCheckResponse)
 	err := c.cc.Invoke(ctx, "/exchangerateservice.ExchangeRateService/HealthCheck", in, out, opts...)
@@ -57,6 +266,25 @@ func (c *exchangeRateServiceClient) HealthCheck(ctx context.Context, in *HealthC
 // for forward compatibility
 type ExchangeRateServiceServer interface {
 	GetRates(context.Context, *GetRatesRequest) (*GetRatesResponse, error)
+	BatchGetRates(context.Context, *BatchGetRatesRequest) (*BatchGetRatesResponse, error)
+	GetRateAt(context.Context, *GetRateAtRequest) (*GetRateAtResponse, error)
+	ListRates(context.Context, *ListRatesRequest) (*ListRatesResponse, error)
+	GetRateStats(context.Context, *GetRateStatsRequest) (*GetRateStatsResponse, error)
+	GetOrderBookAt(context.Context, *GetOrderBookAtRequest) (*GetOrderBookAtResponse, error)
+	ExportRates(*ExportRatesRequest, ExchangeRateService_ExportRatesServer) error
+	SubscribeRates(*SubscribeRatesRequest, ExchangeRateService_SubscribeRatesServer) error
+	// Firm quotes.
+	CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error)
+	RedeemQuote(context.Context, *RedeemQuoteRequest) (*RedeemQuoteResponse, error)
+	// Market registry administration.
+	ListMarkets(context.Context, *ListMarketsRequest) (*ListMarketsResponse, error)
+	GetMarket(context.Context, *GetMarketRequest) (*GetMarketResponse, error)
+	UpsertMarket(context.Context, *UpsertMarketRequest) (*UpsertMarketResponse, error)
+	SetMarketEnabled(context.Context, *SetMarketEnabledRequest) (*SetMarketEnabledResponse, error)
+	// Manual rate overrides.
+	SetRateOverride(context.Context, *SetRateOverrideRequest) (*SetRateOverrideResponse, error)
+	ListRateOverrides(context.Context, *ListRateOverridesRequest) (*ListRateOverridesResponse, error)
+	ClearRateOverride(context.Context, *ClearRateOverrideRequest) (*ClearRateOverrideResponse, error)
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedExchangeRateServiceServer()
 }
@@ -68,6 +296,54 @@ type UnimplementedExchangeRateServiceServer struct {
 func (UnimplementedExchangeRateServiceServer) GetRates(context.Context, *GetRatesRequest) (*GetRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRates not implemented")
 }
+func (UnimplementedExchangeRateServiceServer) BatchGetRates(context.Context, *BatchGetRatesRequest) (*BatchGetRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) GetRateAt(context.Context, *GetRateAtRequest) (*GetRateAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateAt not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) ListRates(context.Context, *ListRatesRequest) (*ListRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) GetRateStats(context.Context, *GetRateStatsRequest) (*GetRateStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateStats not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) GetOrderBookAt(context.Context, *GetOrderBookAtRequest) (*GetOrderBookAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBookAt not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) ExportRates(*ExportRatesRequest, ExchangeRateService_ExportRatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) SubscribeRates(*SubscribeRatesRequest, ExchangeRateService_SubscribeRatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuote not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) RedeemQuote(context.Context, *RedeemQuoteRequest) (*RedeemQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemQuote not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) ListMarkets(context.Context, *ListMarketsRequest) (*ListMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarkets not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) GetMarket(context.Context, *GetMarketRequest) (*GetMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarket not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) UpsertMarket(context.Context, *UpsertMarketRequest) (*UpsertMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertMarket not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) SetMarketEnabled(context.Context, *SetMarketEnabledRequest) (*SetMarketEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketEnabled not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) SetRateOverride(context.Context, *SetRateOverrideRequest) (*SetRateOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateOverride not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) ListRateOverrides(context.Context, *ListRateOverridesRequest) (*ListRateOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRateOverrides not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) ClearRateOverride(context.Context, *ClearRateOverrideRequest) (*ClearRateOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearRateOverride not implemented")
+}
 func (UnimplementedExchangeRateServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -102,6 +378,300 @@ func _ExchangeRateService_GetRates_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExchangeRateService_BatchGetRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).BatchGetRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/BatchGetRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).BatchGetRates(ctx, req.(*BatchGetRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_GetRateAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).GetRateAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/GetRateAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).GetRateAt(ctx, req.(*GetRateAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_ListRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).ListRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/ListRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).ListRates(ctx, req.(*ListRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_GetRateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).GetRateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/GetRateStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).GetRateStats(ctx, req.(*GetRateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_GetOrderBookAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderBookAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).GetOrderBookAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/GetOrderBookAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).GetOrderBookAt(ctx, req.(*GetOrderBookAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_ExportRates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExchangeRateServiceServer).ExportRates(m, &exchangeRateServiceExportRatesServer{stream})
+}
+
+type ExchangeRateService_ExportRatesServer interface {
+	Send(*ExportRatesResponse) error
+	grpc.ServerStream
+}
+
+type exchangeRateServiceExportRatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *exchangeRateServiceExportRatesServer) Send(m *ExportRatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExchangeRateService_SubscribeRates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExchangeRateServiceServer).SubscribeRates(m, &exchangeRateServiceSubscribeRatesServer{stream})
+}
+
+type ExchangeRateService_SubscribeRatesServer interface {
+	Send(*SubscribeRatesResponse) error
+	grpc.ServerStream
+}
+
+type exchangeRateServiceSubscribeRatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *exchangeRateServiceSubscribeRatesServer) Send(m *SubscribeRatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExchangeRateService_CreateQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).CreateQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/CreateQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).CreateQuote(ctx, req.(*CreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_RedeemQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).RedeemQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/RedeemQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).RedeemQuote(ctx, req.(*RedeemQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_ListMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).ListMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/ListMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).ListMarkets(ctx, req.(*ListMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_GetMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).GetMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/GetMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).GetMarket(ctx, req.(*GetMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_UpsertMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).UpsertMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/UpsertMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).UpsertMarket(ctx, req.(*UpsertMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_SetMarketEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMarketEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).SetMarketEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/SetMarketEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).SetMarketEnabled(ctx, req.(*SetMarketEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_SetRateOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRateOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).SetRateOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/SetRateOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).SetRateOverride(ctx, req.(*SetRateOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_ListRateOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).ListRateOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/ListRateOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).ListRateOverrides(ctx, req.(*ListRateOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_ClearRateOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRateOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).ClearRateOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exchangerateservice.ExchangeRateService/ClearRateOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).ClearRateOverride(ctx, req.(*ClearRateOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExchangeRateService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -131,11 +701,78 @@ var ExchangeRateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRates",
 			Handler:    _ExchangeRateService_GetRates_Handler,
 		},
+		{
+			MethodName: "BatchGetRates",
+			Handler:    _ExchangeRateService_BatchGetRates_Handler,
+		},
+		{
+			MethodName: "GetRateAt",
+			Handler:    _ExchangeRateService_GetRateAt_Handler,
+		},
+		{
+			MethodName: "ListRates",
+			Handler:    _ExchangeRateService_ListRates_Handler,
+		},
+		{
+			MethodName: "GetRateStats",
+			Handler:    _ExchangeRateService_GetRateStats_Handler,
+		},
+		{
+			MethodName: "GetOrderBookAt",
+			Handler:    _ExchangeRateService_GetOrderBookAt_Handler,
+		},
+		{
+			MethodName: "CreateQuote",
+			Handler:    _ExchangeRateService_CreateQuote_Handler,
+		},
+		{
+			MethodName: "RedeemQuote",
+			Handler:    _ExchangeRateService_RedeemQuote_Handler,
+		},
+		{
+			MethodName: "ListMarkets",
+			Handler:    _ExchangeRateService_ListMarkets_Handler,
+		},
+		{
+			MethodName: "GetMarket",
+			Handler:    _ExchangeRateService_GetMarket_Handler,
+		},
+		{
+			MethodName: "UpsertMarket",
+			Handler:    _ExchangeRateService_UpsertMarket_Handler,
+		},
+		{
+			MethodName: "SetMarketEnabled",
+			Handler:    _ExchangeRateService_SetMarketEnabled_Handler,
+		},
+		{
+			MethodName: "SetRateOverride",
+			Handler:    _ExchangeRateService_SetRateOverride_Handler,
+		},
+		{
+			MethodName: "ListRateOverrides",
+			Handler:    _ExchangeRateService_ListRateOverrides_Handler,
+		},
+		{
+			MethodName: "ClearRateOverride",
+			Handler:    _ExchangeRateService_ClearRateOverride_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _ExchangeRateService_HealthCheck_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportRates",
+			Handler:       _ExchangeRateService_ExportRates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeRates",
+			Handler:       _ExchangeRateService_SubscribeRates_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "exchangerateservice/api.proto",
 }
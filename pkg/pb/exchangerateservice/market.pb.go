@@ -0,0 +1,401 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/market.proto
+
+package exchangerateservice
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoundingMode int32
+
+const (
+	RoundingMode_ROUNDING_MODE_UNSPECIFIED RoundingMode = 0 // same as ROUNDING_MODE_HALF_EVEN
+	RoundingMode_ROUNDING_MODE_HALF_EVEN   RoundingMode = 1
+	RoundingMode_ROUNDING_MODE_FLOOR       RoundingMode = 2
+	RoundingMode_ROUNDING_MODE_CEIL        RoundingMode = 3
+)
+
+// Enum value maps for RoundingMode.
+var (
+	RoundingMode_name = map[int32]string{
+		0: "ROUNDING_MODE_UNSPECIFIED",
+		1: "ROUNDING_MODE_HALF_EVEN",
+		2: "ROUNDING_MODE_FLOOR",
+		3: "ROUNDING_MODE_CEIL",
+	}
+	RoundingMode_value = map[string]int32{
+		"ROUNDING_MODE_UNSPECIFIED": 0,
+		"ROUNDING_MODE_HALF_EVEN":   1,
+		"ROUNDING_MODE_FLOOR":       2,
+		"ROUNDING_MODE_CEIL":        3,
+	}
+)
+
+func (x RoundingMode) Enum() *RoundingMode {
+	p := new(RoundingMode)
+	*p = x
+	return p
+}
+
+func (x RoundingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoundingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_exchangerateservice_market_proto_enumTypes[0].Descriptor()
+}
+
+func (RoundingMode) Type() protoreflect.EnumType {
+	return &file_exchangerateservice_market_proto_enumTypes[0]
+}
+
+func (x RoundingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoundingMode.Descriptor instead.
+func (RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return file_exchangerateservice_market_proto_rawDescGZIP(), []int{0}
+}
+
+type StoreMode int32
+
+const (
+	StoreMode_STORE_MODE_UNSPECIFIED StoreMode = 0 // service default
+	// Every fetched snapshot is stored.
+	StoreMode_STORE_MODE_ALL StoreMode = 1
+	// A snapshot is stored only when the bid or ask moves, or once the heartbeat interval has passed.
+	StoreMode_STORE_MODE_ON_CHANGE StoreMode = 2
+)
+
+// Enum value maps for StoreMode.
+var (
+	StoreMode_name = map[int32]string{
+		0: "STORE_MODE_UNSPECIFIED",
+		1: "STORE_MODE_ALL",
+		2: "STORE_MODE_ON_CHANGE",
+	}
+	StoreMode_value = map[string]int32{
+		"STORE_MODE_UNSPECIFIED": 0,
+		"STORE_MODE_ALL":         1,
+		"STORE_MODE_ON_CHANGE":   2,
+	}
+)
+
+func (x StoreMode) Enum() *StoreMode {
+	p := new(StoreMode)
+	*p = x
+	return p
+}
+
+func (x StoreMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StoreMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_exchangerateservice_market_proto_enumTypes[1].Descriptor()
+}
+
+func (StoreMode) Type() protoreflect.EnumType {
+	return &file_exchangerateservice_market_proto_enumTypes[1]
+}
+
+func (x StoreMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StoreMode.Descriptor instead.
+func (StoreMode) EnumDescriptor() ([]byte, []int) {
+	return file_exchangerateservice_market_proto_rawDescGZIP(), []int{1}
+}
+
+type Market struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol        string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	BaseCurrency  string `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	// Provider the rates are fetched from, only "garantex" is supported.
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Number of decimal places prices are rounded to, up to 18.
+	Precision int32        `protobuf:"varint,5,opt,name=precision,proto3" json:"precision,omitempty"`
+	Rounding  RoundingMode `protobuf:"varint,6,opt,name=rounding,proto3,enum=exchangerateservice.RoundingMode" json:"rounding,omitempty"`
+	// Polling interval in seconds.
+	PollingInterval int64 `protobuf:"varint,7,opt,name=polling_interval,json=pollingInterval,proto3" json:"polling_interval,omitempty"`
+	// Maximum age of a stored rate served by history lookups, in seconds. 0 uses the service default.
+	StalenessLimit int64 `protobuf:"varint,8,opt,name=staleness_limit,json=stalenessLimit,proto3" json:"staleness_limit,omitempty"`
+	Enabled        bool  `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Unix timestamps (seconds).
+	CreatedAt int64     `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64     `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StoreMode StoreMode `protobuf:"varint,12,opt,name=store_mode,json=storeMode,proto3,enum=exchangerateservice.StoreMode" json:"store_mode,omitempty"`
+	// Longest time an unchanged snapshot goes unstored in the on-change mode, in seconds. 0 uses the service default.
+	HeartbeatInterval int64 `protobuf:"varint,13,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_market_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_market_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_market_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Market) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Market) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *Market) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *Market) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Market) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *Market) GetRounding() RoundingMode {
+	if x != nil {
+		return x.Rounding
+	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
+}
+
+func (x *Market) GetPollingInterval() int64 {
+	if x != nil {
+		return x.PollingInterval
+	}
+	return 0
+}
+
+func (x *Market) GetStalenessLimit() int64 {
+	if x != nil {
+		return x.StalenessLimit
+	}
+	return 0
+}
+
+func (x *Market) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Market) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Market) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Market) GetStoreMode() StoreMode {
+	if x != nil {
+		return x.StoreMode
+	}
+	return StoreMode_STORE_MODE_UNSPECIFIED
+}
+
+func (x *Market) GetHeartbeatInterval() int64 {
+	if x != nil {
+		return x.HeartbeatInterval
+	}
+	return 0
+}
+
+var File_exchangerateservice_market_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_market_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xff, 0x03, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x25, 0x0a, 0x0e, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x3d, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74,
+	0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x2a, 0x7b, 0x0a, 0x0c, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x4f, 0x55,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x55, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4c, 0x4f, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x43, 0x45, 0x49, 0x4c, 0x10, 0x03, 0x2a, 0x55, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x42, 0x45, 0x5a,
+	0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x53, 0x48,
+	0x2d, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
+	0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_market_proto_rawDescOnce sync.Once
+	file_exchangerateservice_market_proto_rawDescData = file_exchangerateservice_market_proto_rawDesc
+)
+
+func file_exchangerateservice_market_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_market_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_market_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_market_proto_rawDescData)
+	})
+	return file_exchangerateservice_market_proto_rawDescData
+}
+
+var file_exchangerateservice_market_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_exchangerateservice_market_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_exchangerateservice_market_proto_goTypes = []interface{}{
+	(RoundingMode)(0), // 0: exchangerateservice.RoundingMode
+	(StoreMode)(0),    // 1: exchangerateservice.StoreMode
+	(*Market)(nil),    // 2: exchangerateservice.Market
+}
+var file_exchangerateservice_market_proto_depIdxs = []int32{
+	0, // 0: exchangerateservice.Market.rounding:type_name -> exchangerateservice.RoundingMode
+	1, // 1: exchangerateservice.Market.store_mode:type_name -> exchangerateservice.StoreMode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_market_proto_init() }
+func file_exchangerateservice_market_proto_init() {
+	if File_exchangerateservice_market_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_market_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Market); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_market_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_market_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_market_proto_depIdxs,
+		EnumInfos:         file_exchangerateservice_market_proto_enumTypes,
+		MessageInfos:      file_exchangerateservice_market_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_market_proto = out.File
+	file_exchangerateservice_market_proto_rawDesc = nil
+	file_exchangerateservice_market_proto_goTypes = nil
+	file_exchangerateservice_market_proto_depIdxs = nil
+}
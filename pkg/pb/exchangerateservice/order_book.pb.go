@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/order_book.proto
+
+package exchangerateservice
+
+import (
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrderBookLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price  *decimal.Decimal `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Volume *decimal.Decimal `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Amount *decimal.Decimal `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *OrderBookLevel) Reset() {
+	*x = OrderBookLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_order_book_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderBookLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookLevel) ProtoMessage() {}
+
+func (x *OrderBookLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_order_book_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookLevel.ProtoReflect.Descriptor instead.
+func (*OrderBookLevel) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_order_book_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderBookLevel) GetPrice() *decimal.Decimal {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OrderBookLevel) GetVolume() *decimal.Decimal {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *OrderBookLevel) GetAmount() *decimal.Decimal {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+var File_exchangerateservice_order_book_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_order_book_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x06, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4b, 0x56, 0x53, 0x48, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_order_book_proto_rawDescOnce sync.Once
+	file_exchangerateservice_order_book_proto_rawDescData = file_exchangerateservice_order_book_proto_rawDesc
+)
+
+func file_exchangerateservice_order_book_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_order_book_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_order_book_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_order_book_proto_rawDescData)
+	})
+	return file_exchangerateservice_order_book_proto_rawDescData
+}
+
+var file_exchangerateservice_order_book_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_exchangerateservice_order_book_proto_goTypes = []interface{}{
+	(*OrderBookLevel)(nil),  // 0: exchangerateservice.OrderBookLevel
+	(*decimal.Decimal)(nil), // 1: google.type.Decimal
+}
+var file_exchangerateservice_order_book_proto_depIdxs = []int32{
+	1, // 0: exchangerateservice.OrderBookLevel.price:type_name -> google.type.Decimal
+	1, // 1: exchangerateservice.OrderBookLevel.volume:type_name -> google.type.Decimal
+	1, // 2: exchangerateservice.OrderBookLevel.amount:type_name -> google.type.Decimal
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_order_book_proto_init() }
+func file_exchangerateservice_order_book_proto_init() {
+	if File_exchangerateservice_order_book_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_order_book_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBookLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_order_book_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_order_book_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_order_book_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_order_book_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_order_book_proto = out.File
+	file_exchangerateservice_order_book_proto_rawDesc = nil
+	file_exchangerateservice_order_book_proto_goTypes = nil
+	file_exchangerateservice_order_book_proto_depIdxs = nil
+}
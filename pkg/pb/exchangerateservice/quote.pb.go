@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/quote.proto
+
+package exchangerateservice
+
+import (
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Quote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed quote id, passed to RedeemQuote.
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Market string `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	// Amount in the base currency.
+	Amount *decimal.Decimal `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Prices guaranteed until expires_at, with the markup of the client applied.
+	AskPrice      *decimal.Decimal `protobuf:"bytes,4,opt,name=ask_price,json=askPrice,proto3" json:"ask_price,omitempty"`
+	BidPrice      *decimal.Decimal `protobuf:"bytes,5,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	RawAskPrice   *decimal.Decimal `protobuf:"bytes,6,opt,name=raw_ask_price,json=rawAskPrice,proto3" json:"raw_ask_price,omitempty"`
+	RawBidPrice   *decimal.Decimal `protobuf:"bytes,7,opt,name=raw_bid_price,json=rawBidPrice,proto3" json:"raw_bid_price,omitempty"`
+	PricingRuleId int64            `protobuf:"varint,8,opt,name=pricing_rule_id,json=pricingRuleId,proto3" json:"pricing_rule_id,omitempty"`
+	// Exchange timestamp of the quoted rate (Unix, seconds).
+	RateTs int64 `protobuf:"varint,9,opt,name=rate_ts,json=rateTs,proto3" json:"rate_ts,omitempty"`
+	// Unix timestamps (seconds), redeemed_at is 0 while the quote is not redeemed.
+	CreatedAt  int64 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt  int64 `protobuf:"varint,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RedeemedAt int64 `protobuf:"varint,12,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_quote_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_quote_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_quote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Quote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Quote) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *Quote) GetAmount() *decimal.Decimal {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Quote) GetAskPrice() *decimal.Decimal {
+	if x != nil {
+		return x.AskPrice
+	}
+	return nil
+}
+
+func (x *Quote) GetBidPrice() *decimal.Decimal {
+	if x != nil {
+		return x.BidPrice
+	}
+	return nil
+}
+
+func (x *Quote) GetRawAskPrice() *decimal.Decimal {
+	if x != nil {
+		return x.RawAskPrice
+	}
+	return nil
+}
+
+func (x *Quote) GetRawBidPrice() *decimal.Decimal {
+	if x != nil {
+		return x.RawBidPrice
+	}
+	return nil
+}
+
+func (x *Quote) GetPricingRuleId() int64 {
+	if x != nil {
+		return x.PricingRuleId
+	}
+	return 0
+}
+
+func (x *Quote) GetRateTs() int64 {
+	if x != nil {
+		return x.RateTs
+	}
+	return 0
+}
+
+func (x *Quote) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Quote) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Quote) GetRedeemedAt() int64 {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return 0
+}
+
+var File_exchangerateservice_quote_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_quote_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xd7, 0x03, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x62,
+	0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x61,
+	0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x41, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0b,
+	0x72, 0x61, 0x77, 0x42, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x54, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x42, 0x45, 0x5a, 0x43, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x53, 0x48, 0x2d, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_quote_proto_rawDescOnce sync.Once
+	file_exchangerateservice_quote_proto_rawDescData = file_exchangerateservice_quote_proto_rawDesc
+)
+
+func file_exchangerateservice_quote_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_quote_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_quote_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_quote_proto_rawDescData)
+	})
+	return file_exchangerateservice_quote_proto_rawDescData
+}
+
+var file_exchangerateservice_quote_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_exchangerateservice_quote_proto_goTypes = []interface{}{
+	(*Quote)(nil),           // 0: exchangerateservice.Quote
+	(*decimal.Decimal)(nil), // 1: google.type.Decimal
+}
+var file_exchangerateservice_quote_proto_depIdxs = []int32{
+	1, // 0: exchangerateservice.Quote.amount:type_name -> google.type.Decimal
+	1, // 1: exchangerateservice.Quote.ask_price:type_name -> google.type.Decimal
+	1, // 2: exchangerateservice.Quote.bid_price:type_name -> google.type.Decimal
+	1, // 3: exchangerateservice.Quote.raw_ask_price:type_name -> google.type.Decimal
+	1, // 4: exchangerateservice.Quote.raw_bid_price:type_name -> google.type.Decimal
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_quote_proto_init() }
+func file_exchangerateservice_quote_proto_init() {
+	if File_exchangerateservice_quote_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_quote_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_quote_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_quote_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_quote_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_quote_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_quote_proto = out.File
+	file_exchangerateservice_quote_proto_rawDesc = nil
+	file_exchangerateservice_quote_proto_goTypes = nil
+	file_exchangerateservice_quote_proto_depIdxs = nil
+}
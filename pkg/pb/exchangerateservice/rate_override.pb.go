@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/rate_override.proto
+
+package exchangerateservice
+
+import (
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RateOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market   string           `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	AskPrice *decimal.Decimal `protobuf:"bytes,2,opt,name=ask_price,json=askPrice,proto3" json:"ask_price,omitempty"`
+	BidPrice *decimal.Decimal `protobuf:"bytes,3,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	Reason   string           `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Identity of the admin who set the override.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Unix timestamps (seconds), expires_at is 0 for overrides active until cleared.
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt int64 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *RateOverride) Reset() {
+	*x = RateOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rate_override_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateOverride) ProtoMessage() {}
+
+func (x *RateOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rate_override_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateOverride.ProtoReflect.Descriptor instead.
+func (*RateOverride) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rate_override_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateOverride) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *RateOverride) GetAskPrice() *decimal.Decimal {
+	if x != nil {
+		return x.AskPrice
+	}
+	return nil
+}
+
+func (x *RateOverride) GetBidPrice() *decimal.Decimal {
+	if x != nil {
+		return x.BidPrice
+	}
+	return nil
+}
+
+func (x *RateOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RateOverride) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *RateOverride) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RateOverride) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_exchangerateservice_rate_override_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_rate_override_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x19,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x01, 0x0a, 0x0c, 0x52, 0x61,
+	0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x73, 0x6b,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08,
+	0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x53, 0x48, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_rate_override_proto_rawDescOnce sync.Once
+	file_exchangerateservice_rate_override_proto_rawDescData = file_exchangerateservice_rate_override_proto_rawDesc
+)
+
+func file_exchangerateservice_rate_override_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_rate_override_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_rate_override_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_rate_override_proto_rawDescData)
+	})
+	return file_exchangerateservice_rate_override_proto_rawDescData
+}
+
+var file_exchangerateservice_rate_override_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_exchangerateservice_rate_override_proto_goTypes = []interface{}{
+	(*RateOverride)(nil),    // 0: exchangerateservice.RateOverride
+	(*decimal.Decimal)(nil), // 1: google.type.Decimal
+}
+var file_exchangerateservice_rate_override_proto_depIdxs = []int32{
+	1, // 0: exchangerateservice.RateOverride.ask_price:type_name -> google.type.Decimal
+	1, // 1: exchangerateservice.RateOverride.bid_price:type_name -> google.type.Decimal
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_rate_override_proto_init() }
+func file_exchangerateservice_rate_override_proto_init() {
+	if File_exchangerateservice_rate_override_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_rate_override_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_rate_override_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_rate_override_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_rate_override_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_rate_override_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_rate_override_proto = out.File
+	file_exchangerateservice_rate_override_proto_rawDesc = nil
+	file_exchangerateservice_rate_override_proto_goTypes = nil
+	file_exchangerateservice_rate_override_proto_depIdxs = nil
+}
@@ -0,0 +1,396 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/rpc_batch_get_rates.proto
+
+package exchangerateservice
+
+import (
+	status "google.golang.org/genproto/googleapis/rpc/status"
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The rates are quoted as by GetRates without an amount: an active override is
+// served first and the pricing rules of the client given in the x-client-id
+// metadata are applied to the returned prices.
+type BatchGetRatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Duplicates are collapsed, the number of distinct markets is limited by the service.
+	Markets []string `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+}
+
+func (x *BatchGetRatesRequest) Reset() {
+	*x = BatchGetRatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRatesRequest) ProtoMessage() {}
+
+func (x *BatchGetRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetRatesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetRatesRequest) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_batch_get_rates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchGetRatesRequest) GetMarkets() []string {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+type MarketRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Outcome for this market, the rate fields are set only when the code is OK.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Ts     int64          `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
+	// Prices with the markup of the client applied.
+	AskPrice *decimal.Decimal `protobuf:"bytes,4,opt,name=ask_price,json=askPrice,proto3" json:"ask_price,omitempty"`
+	BidPrice *decimal.Decimal `protobuf:"bytes,5,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	// True when the rate was served from the recent fetches within the polling interval of the market.
+	Cached bool `protobuf:"varint,6,opt,name=cached,proto3" json:"cached,omitempty"`
+	// Prices of the provider.
+	RawAskPrice *decimal.Decimal `protobuf:"bytes,7,opt,name=raw_ask_price,json=rawAskPrice,proto3" json:"raw_ask_price,omitempty"`
+	RawBidPrice *decimal.Decimal `protobuf:"bytes,8,opt,name=raw_bid_price,json=rawBidPrice,proto3" json:"raw_bid_price,omitempty"`
+	// Id of the applied pricing rule, 0 when none applies.
+	PricingRuleId int64 `protobuf:"varint,9,opt,name=pricing_rule_id,json=pricingRuleId,proto3" json:"pricing_rule_id,omitempty"`
+	// Set when the raw prices come from a manual override instead of the provider.
+	Overridden bool `protobuf:"varint,10,opt,name=overridden,proto3" json:"overridden,omitempty"`
+}
+
+func (x *MarketRate) Reset() {
+	*x = MarketRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketRate) ProtoMessage() {}
+
+func (x *MarketRate) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketRate.ProtoReflect.Descriptor instead.
+func (*MarketRate) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_batch_get_rates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarketRate) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *MarketRate) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *MarketRate) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+func (x *MarketRate) GetAskPrice() *decimal.Decimal {
+	if x != nil {
+		return x.AskPrice
+	}
+	return nil
+}
+
+func (x *MarketRate) GetBidPrice() *decimal.Decimal {
+	if x != nil {
+		return x.BidPrice
+	}
+	return nil
+}
+
+func (x *MarketRate) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *MarketRate) GetRawAskPrice() *decimal.Decimal {
+	if x != nil {
+		return x.RawAskPrice
+	}
+	return nil
+}
+
+func (x *MarketRate) GetRawBidPrice() *decimal.Decimal {
+	if x != nil {
+		return x.RawBidPrice
+	}
+	return nil
+}
+
+func (x *MarketRate) GetPricingRuleId() int64 {
+	if x != nil {
+		return x.PricingRuleId
+	}
+	return 0
+}
+
+func (x *MarketRate) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+type BatchGetRatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One result per distinct market, in request order.
+	Results []*MarketRate `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchGetRatesResponse) Reset() {
+	*x = BatchGetRatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRatesResponse) ProtoMessage() {}
+
+func (x *BatchGetRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetRatesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetRatesResponse) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_batch_get_rates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchGetRatesResponse) GetResults() []*MarketRate {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_exchangerateservice_rpc_batch_get_rates_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_rpc_batch_get_rates_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x13, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x9a, 0x03, 0x0a, 0x0a, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x12, 0x31, 0x0a,
+	0x09, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x31, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0d, 0x72,
+	0x61, 0x77, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x41, 0x73, 0x6b,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x69, 0x64,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x42, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x61,
+	0x74, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x45, 0x5a, 0x43, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x53, 0x48, 0x2d, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_rpc_batch_get_rates_proto_rawDescOnce sync.Once
+	file_exchangerateservice_rpc_batch_get_rates_proto_rawDescData = file_exchangerateservice_rpc_batch_get_rates_proto_rawDesc
+)
+
+func file_exchangerateservice_rpc_batch_get_rates_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_rpc_batch_get_rates_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_rpc_batch_get_rates_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_rpc_batch_get_rates_proto_rawDescData)
+	})
+	return file_exchangerateservice_rpc_batch_get_rates_proto_rawDescData
+}
+
+var file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_exchangerateservice_rpc_batch_get_rates_proto_goTypes = []interface{}{
+	(*BatchGetRatesRequest)(nil),  // 0: exchangerateservice.BatchGetRatesRequest
+	(*MarketRate)(nil),            // 1: exchangerateservice.MarketRate
+	(*BatchGetRatesResponse)(nil), // 2: exchangerateservice.BatchGetRatesResponse
+	(*status.Status)(nil),         // 3: google.rpc.Status
+	(*decimal.Decimal)(nil),       // 4: google.type.Decimal
+}
+var file_exchangerateservice_rpc_batch_get_rates_proto_depIdxs = []int32{
+	3, // 0: exchangerateservice.MarketRate.status:type_name -> google.rpc.Status
+	4, // 1: exchangerateservice.MarketRate.ask_price:type_name -> google.type.Decimal
+	4, // 2: exchangerateservice.MarketRate.bid_price:type_name -> google.type.Decimal
+	4, // 3: exchangerateservice.MarketRate.raw_ask_price:type_name -> google.type.Decimal
+	4, // 4: exchangerateservice.MarketRate.raw_bid_price:type_name -> google.type.Decimal
+	1, // 5: exchangerateservice.BatchGetRatesResponse.results:type_name -> exchangerateservice.MarketRate
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_rpc_batch_get_rates_proto_init() }
+func file_exchangerateservice_rpc_batch_get_rates_proto_init() {
+	if File_exchangerateservice_rpc_batch_get_rates_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetRatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetRatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_rpc_batch_get_rates_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_rpc_batch_get_rates_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_rpc_batch_get_rates_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_rpc_batch_get_rates_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_rpc_batch_get_rates_proto = out.File
+	file_exchangerateservice_rpc_batch_get_rates_proto_rawDesc = nil
+	file_exchangerateservice_rpc_batch_get_rates_proto_goTypes = nil
+	file_exchangerateservice_rpc_batch_get_rates_proto_depIdxs = nil
+}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/rpc_clear_rate_override.proto
+
+package exchangerateservice
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Admin call: the identity of the admin is the actor of the bearer token in the
+// authorization metadata, which is required.
+type ClearRateOverrideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ClearRateOverrideRequest) Reset() {
+	*x = ClearRateOverrideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_clear_rate_override_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRateOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRateOverrideRequest) ProtoMessage() {}
+
+func (x *ClearRateOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_clear_rate_override_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRateOverrideRequest.ProtoReflect.Descriptor instead.
+func (*ClearRateOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_clear_rate_override_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClearRateOverrideRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *ClearRateOverrideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ClearRateOverrideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearRateOverrideResponse) Reset() {
+	*x = ClearRateOverrideResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_clear_rate_override_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRateOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRateOverrideResponse) ProtoMessage() {}
+
+func (x *ClearRateOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_clear_rate_override_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRateOverrideResponse.ProtoReflect.Descriptor instead.
+func (*ClearRateOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_clear_rate_override_proto_rawDescGZIP(), []int{1}
+}
+
+var File_exchangerateservice_rpc_clear_rate_override_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_rpc_clear_rate_override_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x4a, 0x0a, 0x18, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x52, 0x61, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x61, 0x74,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4b, 0x56, 0x53, 0x48, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_rpc_clear_rate_override_proto_rawDescOnce sync.Once
+	file_exchangerateservice_rpc_clear_rate_override_proto_rawDescData = file_exchangerateservice_rpc_clear_rate_override_proto_rawDesc
+)
+
+func file_exchangerateservice_rpc_clear_rate_override_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_rpc_clear_rate_override_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_rpc_clear_rate_override_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_rpc_clear_rate_override_proto_rawDescData)
+	})
+	return file_exchangerateservice_rpc_clear_rate_override_proto_rawDescData
+}
+
+var file_exchangerateservice_rpc_clear_rate_override_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_exchangerateservice_rpc_clear_rate_override_proto_goTypes = []interface{}{
+	(*ClearRateOverrideRequest)(nil),  // 0: exchangerateservice.ClearRateOverrideRequest
+	(*ClearRateOverrideResponse)(nil), // 1: exchangerateservice.ClearRateOverrideResponse
+}
+var file_exchangerateservice_rpc_clear_rate_override_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_rpc_clear_rate_override_proto_init() }
+func file_exchangerateservice_rpc_clear_rate_override_proto_init() {
+	if File_exchangerateservice_rpc_clear_rate_override_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_rpc_clear_rate_override_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRateOverrideRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_clear_rate_override_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRateOverrideResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_rpc_clear_rate_override_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_rpc_clear_rate_override_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_rpc_clear_rate_override_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_rpc_clear_rate_override_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_rpc_clear_rate_override_proto = out.File
+	file_exchangerateservice_rpc_clear_rate_override_proto_rawDesc = nil
+	file_exchangerateservice_rpc_clear_rate_override_proto_goTypes = nil
+	file_exchangerateservice_rpc_clear_rate_override_proto_depIdxs = nil
+}
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/rpc_get_order_book_at.proto
+
+package exchangerateservice
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetOrderBookAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Unix timestamp (seconds) to look the book up at.
+	Ts int64 `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	// Maximum age of the snapshot relative to ts, in seconds. 0 uses the service default.
+	MaxStaleness int64 `protobuf:"varint,3,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
+}
+
+func (x *GetOrderBookAtRequest) Reset() {
+	*x = GetOrderBookAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_get_order_book_at_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderBookAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookAtRequest) ProtoMessage() {}
+
+func (x *GetOrderBookAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_get_order_book_at_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookAtRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderBookAtRequest) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_get_order_book_at_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetOrderBookAtRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *GetOrderBookAtRequest) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+func (x *GetOrderBookAtRequest) GetMaxStaleness() int64 {
+	if x != nil {
+		return x.MaxStaleness
+	}
+	return 0
+}
+
+type GetOrderBookAtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Exchange timestamp of the snapshot the book was stored with (Unix, seconds).
+	Ts int64 `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	// Levels are ordered from the best price, up to the configured depth.
+	Asks []*OrderBookLevel `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks,omitempty"`
+	Bids []*OrderBookLevel `protobuf:"bytes,4,rep,name=bids,proto3" json:"bids,omitempty"`
+}
+
+func (x *GetOrderBookAtResponse) Reset() {
+	*x = GetOrderBookAtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_get_order_book_at_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderBookAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookAtResponse) ProtoMessage() {}
+
+func (x *GetOrderBookAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_get_order_book_at_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookAtResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderBookAtResponse) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_get_order_book_at_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetOrderBookAtResponse) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *GetOrderBookAtResponse) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+func (x *GetOrderBookAtResponse) GetAsks() []*OrderBookLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *GetOrderBookAtResponse) GetBids() []*OrderBookLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+var File_exchangerateservice_rpc_get_order_book_at_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_rpc_get_order_book_at_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x24, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x41, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65,
+	0x73, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x37,
+	0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x53, 0x48, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x2f,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_rpc_get_order_book_at_proto_rawDescOnce sync.Once
+	file_exchangerateservice_rpc_get_order_book_at_proto_rawDescData = file_exchangerateservice_rpc_get_order_book_at_proto_rawDesc
+)
+
+func file_exchangerateservice_rpc_get_order_book_at_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_rpc_get_order_book_at_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_rpc_get_order_book_at_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_rpc_get_order_book_at_proto_rawDescData)
+	})
+	return file_exchangerateservice_rpc_get_order_book_at_proto_rawDescData
+}
+
+var file_exchangerateservice_rpc_get_order_book_at_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_exchangerateservice_rpc_get_order_book_at_proto_goTypes = []interface{}{
+	(*GetOrderBookAtRequest)(nil),  // 0: exchangerateservice.GetOrderBookAtRequest
+	(*GetOrderBookAtResponse)(nil), // 1: exchangerateservice.GetOrderBookAtResponse
+	(*OrderBookLevel)(nil),         // 2: exchangerateservice.OrderBookLevel
+}
+var file_exchangerateservice_rpc_get_order_book_at_proto_depIdxs = []int32{
+	2, // 0: exchangerateservice.GetOrderBookAtResponse.asks:type_name -> exchangerateservice.OrderBookLevel
+	2, // 1: exchangerateservice.GetOrderBookAtResponse.bids:type_name -> exchangerateservice.OrderBookLevel
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_rpc_get_order_book_at_proto_init() }
+func file_exchangerateservice_rpc_get_order_book_at_proto_init() {
+	if File_exchangerateservice_rpc_get_order_book_at_proto != nil {
+		return
+	}
+	file_exchangerateservice_order_book_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_rpc_get_order_book_at_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderBookAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_get_order_book_at_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderBookAtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_rpc_get_order_book_at_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_rpc_get_order_book_at_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_rpc_get_order_book_at_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_rpc_get_order_book_at_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_rpc_get_order_book_at_proto = out.File
+	file_exchangerateservice_rpc_get_order_book_at_proto_rawDesc = nil
+	file_exchangerateservice_rpc_get_order_book_at_proto_goTypes = nil
+	file_exchangerateservice_rpc_get_order_book_at_proto_depIdxs = nil
+}
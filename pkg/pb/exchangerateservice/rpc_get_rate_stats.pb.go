@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/rpc_get_rate_stats.proto
+
+package exchangerateservice
+
+import (
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRateStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Unix timestamp (seconds), inclusive.
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Unix timestamp (seconds), exclusive. 0 means now.
+	To int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Percentiles to compute, each in (0, 100].
+	Percentiles []float64 `protobuf:"fixed64,4,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (x *GetRateStatsRequest) Reset() {
+	*x = GetRateStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRateStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateStatsRequest) ProtoMessage() {}
+
+func (x *GetRateStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRateStatsRequest) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_get_rate_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRateStatsRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *GetRateStatsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetRateStatsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetRateStatsRequest) GetPercentiles() []float64 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type PercentileValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percentile float64          `protobuf:"fixed64,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Value      *decimal.Decimal `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *PercentileValue) Reset() {
+	*x = PercentileValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PercentileValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PercentileValue) ProtoMessage() {}
+
+func (x *PercentileValue) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PercentileValue.ProtoReflect.Descriptor instead.
+func (*PercentileValue) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_get_rate_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PercentileValue) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *PercentileValue) GetValue() *decimal.Decimal {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type PriceStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time-weighted average price over the window.
+	Twap *decimal.Decimal `protobuf:"bytes,1,opt,name=twap,proto3" json:"twap,omitempty"`
+	Min  *decimal.Decimal `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max  *decimal.Decimal `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	// Realized volatility: square root of the sum of squared log returns between consecutive snapshots.
+	Volatility  float64            `protobuf:"fixed64,4,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	Percentiles []*PercentileValue `protobuf:"bytes,5,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (x *PriceStats) Reset() {
+	*x = PriceStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceStats) ProtoMessage() {}
+
+func (x *PriceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceStats.ProtoReflect.Descriptor instead.
+func (*PriceStats) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_get_rate_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PriceStats) GetTwap() *decimal.Decimal {
+	if x != nil {
+		return x.Twap
+	}
+	return nil
+}
+
+func (x *PriceStats) GetMin() *decimal.Decimal {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *PriceStats) GetMax() *decimal.Decimal {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *PriceStats) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *PriceStats) GetPercentiles() []*PercentileValue {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type GetRateStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	From   int64  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     int64  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Number of snapshots the statistics are computed on, including the one in effect at the window start.
+	Samples int64 `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	// "memory" for the recent ticks of the instance, "storage" for the stored history.
+	Source string      `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Bid    *PriceStats `protobuf:"bytes,6,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask    *PriceStats `protobuf:"bytes,7,opt,name=ask,proto3" json:"ask,omitempty"`
+	Mid    *PriceStats `protobuf:"bytes,8,opt,name=mid,proto3" json:"mid,omitempty"`
+	// Resolution of the history the statistics are computed on.
+	Resolution Resolution `protobuf:"varint,9,opt,name=resolution,proto3,enum=exchangerateservice.Resolution" json:"resolution,omitempty"`
+}
+
+func (x *GetRateStatsResponse) Reset() {
+	*x = GetRateStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRateStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateStatsResponse) ProtoMessage() {}
+
+func (x *GetRateStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetRateStatsResponse) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_get_rate_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRateStatsResponse) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *GetRateStatsResponse) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetRateStatsResponse) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetRateStatsResponse) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *GetRateStatsResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetRateStatsResponse) GetBid() *PriceStats {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+func (x *GetRateStatsResponse) GetAsk() *PriceStats {
+	if x != nil {
+		return x.Ask
+	}
+	return nil
+}
+
+func (x *GetRateStatsResponse) GetMid() *PriceStats {
+	if x != nil {
+		return x.Mid
+	}
+	return nil
+}
+
+func (x *GetRateStatsResponse) GetResolution() Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return Resolution_RESOLUTION_UNSPECIFIED
+}
+
+var File_exchangerateservice_rpc_get_rate_stats_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_rpc_get_rate_stats_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x73, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x0f,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12,
+	0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x0a,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x77,
+	0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x04,
+	0x74, 0x77, 0x61, 0x70, 0x12, 0x26, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xde, 0x02, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x31, 0x0a, 0x03, 0x6d, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x03, 0x6d, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x45, 0x5a,
+	0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x53, 0x48,
+	0x2d, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
+	0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_rpc_get_rate_stats_proto_rawDescOnce sync.Once
+	file_exchangerateservice_rpc_get_rate_stats_proto_rawDescData = file_exchangerateservice_rpc_get_rate_stats_proto_rawDesc
+)
+
+func file_exchangerateservice_rpc_get_rate_stats_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_rpc_get_rate_stats_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_rpc_get_rate_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_rpc_get_rate_stats_proto_rawDescData)
+	})
+	return file_exchangerateservice_rpc_get_rate_stats_proto_rawDescData
+}
+
+var file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_exchangerateservice_rpc_get_rate_stats_proto_goTypes = []interface{}{
+	(*GetRateStatsRequest)(nil),  // 0: exchangerateservice.GetRateStatsRequest
+	(*PercentileValue)(nil),      // 1: exchangerateservice.PercentileValue
+	(*PriceStats)(nil),           // 2: exchangerateservice.PriceStats
+	(*GetRateStatsResponse)(nil), // 3: exchangerateservice.GetRateStatsResponse
+	(*decimal.Decimal)(nil),      // 4: google.type.Decimal
+	(Resolution)(0),              // 5: exchangerateservice.Resolution
+}
+var file_exchangerateservice_rpc_get_rate_stats_proto_depIdxs = []int32{
+	4, // 0: exchangerateservice.PercentileValue.value:type_name -> google.type.Decimal
+	4, // 1: exchangerateservice.PriceStats.twap:type_name -> google.type.Decimal
+	4, // 2: exchangerateservice.PriceStats.min:type_name -> google.type.Decimal
+	4, // 3: exchangerateservice.PriceStats.max:type_name -> google.type.Decimal
+	1, // 4: exchangerateservice.PriceStats.percentiles:type_name -> exchangerateservice.PercentileValue
+	2, // 5: exchangerateservice.GetRateStatsResponse.bid:type_name -> exchangerateservice.PriceStats
+	2, // 6: exchangerateservice.GetRateStatsResponse.ask:type_name -> exchangerateservice.PriceStats
+	2, // 7: exchangerateservice.GetRateStatsResponse.mid:type_name -> exchangerateservice.PriceStats
+	5, // 8: exchangerateservice.GetRateStatsResponse.resolution:type_name -> exchangerateservice.Resolution
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_rpc_get_rate_stats_proto_init() }
+func file_exchangerateservice_rpc_get_rate_stats_proto_init() {
+	if File_exchangerateservice_rpc_get_rate_stats_proto != nil {
+		return
+	}
+	file_exchangerateservice_exchange_rate_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRateStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PercentileValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRateStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_rpc_get_rate_stats_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_rpc_get_rate_stats_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_rpc_get_rate_stats_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_rpc_get_rate_stats_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_rpc_get_rate_stats_proto = out.File
+	file_exchangerateservice_rpc_get_rate_stats_proto_rawDesc = nil
+	file_exchangerateservice_rpc_get_rate_stats_proto_goTypes = nil
+	file_exchangerateservice_rpc_get_rate_stats_proto_depIdxs = nil
+}
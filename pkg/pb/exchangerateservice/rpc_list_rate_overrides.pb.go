@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/rpc_list_rate_overrides.proto
+
+package exchangerateservice
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListRateOverridesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRateOverridesRequest) Reset() {
+	*x = ListRateOverridesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_list_rate_overrides_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRateOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateOverridesRequest) ProtoMessage() {}
+
+func (x *ListRateOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_list_rate_overrides_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListRateOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescGZIP(), []int{0}
+}
+
+type ListRateOverridesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Overrides that are not expired.
+	Overrides []*RateOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+}
+
+func (x *ListRateOverridesResponse) Reset() {
+	*x = ListRateOverridesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_list_rate_overrides_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRateOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateOverridesResponse) ProtoMessage() {}
+
+func (x *ListRateOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_list_rate_overrides_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListRateOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRateOverridesResponse) GetOverrides() []*RateOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+var File_exchangerateservice_rpc_list_rate_overrides_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_rpc_list_rate_overrides_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x27, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x42, 0x45, 0x5a, 0x43, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x53, 0x48, 0x2d, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescOnce sync.Once
+	file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescData = file_exchangerateservice_rpc_list_rate_overrides_proto_rawDesc
+)
+
+func file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescData)
+	})
+	return file_exchangerateservice_rpc_list_rate_overrides_proto_rawDescData
+}
+
+var file_exchangerateservice_rpc_list_rate_overrides_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_exchangerateservice_rpc_list_rate_overrides_proto_goTypes = []interface{}{
+	(*ListRateOverridesRequest)(nil),  // 0: exchangerateservice.ListRateOverridesRequest
+	(*ListRateOverridesResponse)(nil), // 1: exchangerateservice.ListRateOverridesResponse
+	(*RateOverride)(nil),              // 2: exchangerateservice.RateOverride
+}
+var file_exchangerateservice_rpc_list_rate_overrides_proto_depIdxs = []int32{
+	2, // 0: exchangerateservice.ListRateOverridesResponse.overrides:type_name -> exchangerateservice.RateOverride
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_rpc_list_rate_overrides_proto_init() }
+func file_exchangerateservice_rpc_list_rate_overrides_proto_init() {
+	if File_exchangerateservice_rpc_list_rate_overrides_proto != nil {
+		return
+	}
+	file_exchangerateservice_rate_override_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_rpc_list_rate_overrides_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRateOverridesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_list_rate_overrides_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRateOverridesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_rpc_list_rate_overrides_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_rpc_list_rate_overrides_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_rpc_list_rate_overrides_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_rpc_list_rate_overrides_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_rpc_list_rate_overrides_proto = out.File
+	file_exchangerateservice_rpc_list_rate_overrides_proto_rawDesc = nil
+	file_exchangerateservice_rpc_list_rate_overrides_proto_goTypes = nil
+	file_exchangerateservice_rpc_list_rate_overrides_proto_depIdxs = nil
+}
@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v5.29.3
+// source: exchangerateservice/rpc_redeem_quote.proto
+
+package exchangerateservice
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RedeemQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuoteId string `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+}
+
+func (x *RedeemQuoteRequest) Reset() {
+	*x = RedeemQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_redeem_quote_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemQuoteRequest) ProtoMessage() {}
+
+func (x *RedeemQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_redeem_quote_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemQuoteRequest.ProtoReflect.Descriptor instead.
+func (*RedeemQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_redeem_quote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RedeemQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+type RedeemQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quote *Quote `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (x *RedeemQuoteResponse) Reset() {
+	*x = RedeemQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exchangerateservice_rpc_redeem_quote_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemQuoteResponse) ProtoMessage() {}
+
+func (x *RedeemQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchangerateservice_rpc_redeem_quote_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemQuoteResponse.ProtoReflect.Descriptor instead.
+func (*RedeemQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_exchangerateservice_rpc_redeem_quote_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RedeemQuoteResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+var File_exchangerateservice_rpc_redeem_quote_proto protoreflect.FileDescriptor
+
+var file_exchangerateservice_rpc_redeem_quote_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x1a, 0x1f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x12, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x42, 0x45, 0x5a, 0x43,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x53, 0x48, 0x2d,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exchangerateservice_rpc_redeem_quote_proto_rawDescOnce sync.Once
+	file_exchangerateservice_rpc_redeem_quote_proto_rawDescData = file_exchangerateservice_rpc_redeem_quote_proto_rawDesc
+)
+
+func file_exchangerateservice_rpc_redeem_quote_proto_rawDescGZIP() []byte {
+	file_exchangerateservice_rpc_redeem_quote_proto_rawDescOnce.Do(func() {
+		file_exchangerateservice_rpc_redeem_quote_proto_rawDescData = protoimpl.X.CompressGZIP(file_exchangerateservice_rpc_redeem_quote_proto_rawDescData)
+	})
+	return file_exchangerateservice_rpc_redeem_quote_proto_rawDescData
+}
+
+var file_exchangerateservice_rpc_redeem_quote_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_exchangerateservice_rpc_redeem_quote_proto_goTypes = []interface{}{
+	(*RedeemQuoteRequest)(nil),  // 0: exchangerateservice.RedeemQuoteRequest
+	(*RedeemQuoteResponse)(nil), // 1: exchangerateservice.RedeemQuoteResponse
+	(*Quote)(nil),               // 2: exchangerateservice.Quote
+}
+var file_exchangerateservice_rpc_redeem_quote_proto_depIdxs = []int32{
+	2, // 0: exchangerateservice.RedeemQuoteResponse.quote:type_name -> exchangerateservice.Quote
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_exchangerateservice_rpc_redeem_quote_proto_init() }
+func file_exchangerateservice_rpc_redeem_quote_proto_init() {
+	if File_exchangerateservice_rpc_redeem_quote_proto != nil {
+		return
+	}
+	file_exchangerateservice_quote_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_exchangerateservice_rpc_redeem_quote_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exchangerateservice_rpc_redeem_quote_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_exchangerateservice_rpc_redeem_quote_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_exchangerateservice_rpc_redeem_quote_proto_goTypes,
+		DependencyIndexes: file_exchangerateservice_rpc_redeem_quote_proto_depIdxs,
+		MessageInfos:      file_exchangerateservice_rpc_redeem_quote_proto_msgTypes,
+	}.Build()
+	File_exchangerateservice_rpc_redeem_quote_proto = out.File
+	file_exchangerateservice_rpc_redeem_quote_proto_rawDesc = nil
+	file_exchangerateservice_rpc_redeem_quote_proto_goTypes = nil
+	file_exchangerateservice_rpc_redeem_quote_proto_depIdxs = nil
+}